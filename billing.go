@@ -0,0 +1,159 @@
+package pipedrive
+
+import (
+	"net/http"
+	"sync"
+)
+
+// Add-on codes
+//
+// Other add-ons can be checked as AddonCode("<code>").
+type AddonCode string
+
+const (
+	AddonLeadBooster AddonCode = "leadbooster_v2"
+	AddonProspector  AddonCode = "prospector"
+	AddonSmartDocs   AddonCode = "smart_docs_v2"
+	AddonProjects    AddonCode = "projects"
+	AddonCampaigns   AddonCode = "campaigns_v2"
+)
+
+type Addon struct {
+	Code AddonCode `json:"code"`
+}
+
+// Cached add-ons of the company
+type addonCache struct {
+	mu sync.Mutex
+
+	// Nil until the add-ons are loaded
+	codes map[AddonCode]bool
+
+	// Request in progress, other callers wait for its result
+	loading *addonLoad
+}
+
+type addonLoad struct {
+	done  chan struct{}
+	codes map[AddonCode]bool
+	err   error
+}
+
+// Guards creation of the caches of all clients
+var addonCacheInit sync.Mutex
+
+// Get all add-ons for a single company
+//
+// Returns the add-ons for a single company.
+//
+// https://developers.pipedrive.com/docs/api/v1/Billing#getCompanyAddons
+func (p *Pipedrive) ListAddons() (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("billing/subscriptions/addons")
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Check whether the company has the add-on
+//
+// The add-ons are requested once and cached by the client, call
+// RefreshAddons to request them again. Failed requests are not cached.
+func (p *Pipedrive) HasAddon(code AddonCode) (bool, error) {
+	codes, err := p.loadAddons(false)
+
+	if err != nil {
+		return false, err
+	}
+
+	return codes[code], nil
+}
+
+// Requests add-ons again and replaces the cached ones
+//
+// If the request fails the cached add-ons are kept and the error is returned.
+func (p *Pipedrive) RefreshAddons() error {
+	_, err := p.loadAddons(true)
+	return err
+}
+
+func (p *Pipedrive) getAddonCache() *addonCache {
+	addonCacheInit.Lock()
+	defer addonCacheInit.Unlock()
+
+	if p.addons == nil {
+		p.addons = &addonCache{}
+	}
+
+	return p.addons
+}
+
+// Returns cached add-ons, requests them if they are not cached yet or
+// refresh is set. Only one request is sent at a time, concurrent callers
+// get its result.
+func (p *Pipedrive) loadAddons(refresh bool) (map[AddonCode]bool, error) {
+	cache := p.getAddonCache()
+
+	cache.mu.Lock()
+
+	if !refresh && cache.codes != nil {
+		codes := cache.codes
+		cache.mu.Unlock()
+		return codes, nil
+	}
+
+	load := cache.loading
+	if load != nil {
+		cache.mu.Unlock()
+		<-load.done
+		return load.codes, load.err
+	}
+
+	load = &addonLoad{done: make(chan struct{})}
+	cache.loading = load
+	cache.mu.Unlock()
+
+	load.codes, load.err = p.fetchAddons()
+
+	cache.mu.Lock()
+	if load.err == nil {
+		cache.codes = load.codes
+	}
+	cache.loading = nil
+	cache.mu.Unlock()
+
+	close(load.done)
+	return load.codes, load.err
+}
+
+// Requests add-ons
+func (p *Pipedrive) fetchAddons() (map[AddonCode]bool, error) {
+	pd_resp, err := p.ListAddons()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var addons []Addon
+
+	// Company without add-ons returns no data
+	if pd_resp.Status >= 400 || pd_resp.Data != nil {
+		err = pd_resp.DecodeData(&addons)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	codes := map[AddonCode]bool{}
+	for _, addon := range addons {
+		codes[addon.Code] = true
+	}
+
+	return codes, nil
+}
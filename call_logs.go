@@ -0,0 +1,237 @@
+package pipedrive
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Outcome of a call
+type CallOutcome string
+
+const (
+	CallOutcomeConnected     CallOutcome = "connected"
+	CallOutcomeNoAnswer      CallOutcome = "no_answer"
+	CallOutcomeLeftMessage   CallOutcome = "left_message"
+	CallOutcomeLeftVoicemail CallOutcome = "left_voicemail"
+	CallOutcomeWrongNumber   CallOutcome = "wrong_number"
+	CallOutcomeBusy          CallOutcome = "busy"
+)
+
+// Duration of a call.
+//
+// Pipedrive keeps it as a string with the number of seconds.
+type CallDuration time.Duration
+
+func (d CallDuration) Seconds() int {
+	return int(time.Duration(d) / time.Second)
+}
+
+func (d CallDuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strconv.Itoa(d.Seconds()))
+}
+
+func (d *CallDuration) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" || string(data) == `""` {
+		*d = 0
+		return nil
+	}
+
+	var num json.Number
+	err := json.Unmarshal(data, &num)
+
+	if err != nil {
+		return err
+	}
+
+	seconds, err := num.Float64()
+
+	if err != nil {
+		return err
+	}
+
+	*d = CallDuration(time.Duration(seconds * float64(time.Second)))
+	return nil
+}
+
+// Call log.
+//
+// The same model is used to add call logs, read-only fields are omitted when empty.
+type CallLog struct {
+	Id string `json:"id,omitempty"`
+
+	// The ID of the owner of the call log. If omitted, the authorized user is used.
+	UserId int `json:"user_id,omitempty"`
+
+	// Linked entities
+	ActivityId int    `json:"activity_id,omitempty"`
+	PersonId   int    `json:"person_id,omitempty"`
+	OrgId      int    `json:"org_id,omitempty"`
+	DealId     int    `json:"deal_id,omitempty"`
+	LeadId     string `json:"lead_id,omitempty"`
+
+	Subject  string       `json:"subject,omitempty"`
+	Duration CallDuration `json:"duration,omitempty"`
+	Outcome  CallOutcome  `json:"outcome"`
+
+	FromPhoneNumber string `json:"from_phone_number,omitempty"`
+	ToPhoneNumber   string `json:"to_phone_number"`
+
+	// Date and time of the call in UTC. Format: YYYY-MM-DD HH:MM:SS
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+
+	// Note for the call log in HTML format
+	Note string `json:"note,omitempty"`
+
+	// Read only fields
+	HasRecording bool `json:"has_recording,omitempty"`
+	CompanyId    int  `json:"company_id,omitempty"`
+}
+
+// Pagination options
+type CallLogsFilter struct {
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+}
+
+// Get all call logs assigned to a particular user
+//
+// Returns all call logs assigned to a particular user.
+//
+// https://developers.pipedrive.com/docs/api/v1/CallLogs#getUserCallLogs
+func (p *Pipedrive) ListCallLogs(f CallLogsFilter) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("callLogs")
+
+	if f.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(f.Start))
+	}
+
+	if f.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(f.Limit))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get details of a call log
+//
+// https://developers.pipedrive.com/docs/api/v1/CallLogs#getCallLog
+func (p *Pipedrive) GetCallLog(id string) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("callLogs/%s", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Add a call log
+//
+// Adds a new call log. Outcome, ToPhoneNumber, StartTime and EndTime are required.
+//
+// https://developers.pipedrive.com/docs/api/v1/CallLogs#addCallLog
+func (p *Pipedrive) AddCallLog(log CallLog) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("callLogs")
+
+	if log.Outcome == "" {
+		return nil, errors.New("Call outcome is required")
+	}
+
+	if log.ToPhoneNumber == "" {
+		return nil, errors.New("To phone number is required")
+	}
+
+	if log.StartTime == "" || log.EndTime == "" {
+		return nil, errors.New("Start time and end time are required")
+	}
+
+	body := log
+	body.Id = ""
+	body.HasRecording = false
+	body.CompanyId = 0
+
+	json_data, err := json.Marshal(body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Attach an audio file to the call log
+//
+// Adds an audio recording to the call log. That audio can be played by those
+// who have access to the call log object. The content of r is streamed.
+//
+// https://developers.pipedrive.com/docs/api/v1/CallLogs#addCallLogAudioFile
+func (p *Pipedrive) AddCallLogRecording(id string, name string, r io.Reader) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("callLogs/%s/recordings", id)
+	url := p.makeApiEndpoint(ep)
+
+	if name == "" {
+		return nil, errors.New("File name is required")
+	}
+
+	return p.postMultipart(url, map[string]string{}, "file", name, r)
+}
+
+// Delete a call log
+//
+// Deletes a call log. If there is an audio recording attached to it,
+// it will also be deleted. The related activity will not be removed
+// by this request.
+//
+// https://developers.pipedrive.com/docs/api/v1/CallLogs#deleteCallLog
+func (p *Pipedrive) DeleteCallLog(id string) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("callLogs/%s", id)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
@@ -0,0 +1,301 @@
+package pipedrive
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Storage of the change feed position
+type ChangeFeedCheckpointStore interface {
+	// Returns the stored timestamp, empty string if there is none
+	Load(ctx context.Context) (string, error)
+
+	// Stores the timestamp
+	Save(ctx context.Context, timestamp string) error
+}
+
+// In-memory ChangeFeedCheckpointStore. The position is lost on restart.
+type MemoryCheckpointStore struct {
+	mu        sync.Mutex
+	timestamp string
+}
+
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{}
+}
+
+func (s *MemoryCheckpointStore) Load(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.timestamp, nil
+}
+
+func (s *MemoryCheckpointStore) Save(ctx context.Context, timestamp string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.timestamp = timestamp
+	return nil
+}
+
+// Function processing a change
+type ChangeFunc func(ctx context.Context, c RecentChange) error
+
+type changeRoute struct {
+	item RecentsItem
+	fn   ChangeFunc
+}
+
+// Incremental feed of the changes built on top of recents.
+//
+// Polls recents, calls the handlers registered for the changed items and
+// stores the timestamp of the last processed change. Changes are delivered
+// at least once: the changes made at the checkpoint timestamp are delivered
+// again with the next poll, and a failed poll is retried from the same
+// checkpoint.
+//
+//	feed := pd.NewChangeFeed(store, time.Minute, pipedrive.RecentsDeal)
+//	feed.OnDeal(func(ctx context.Context, deal pipedrive.Deal) error {
+//		...
+//	})
+//	err := feed.Run(ctx)
+type ChangeFeed struct {
+	// Timestamp to start from when there is no checkpoint yet.
+	// If empty the feed starts from the current time.
+	InitialTimestamp string
+
+	// Called with the errors of Run. If nil Run stops on the first error.
+	ErrorHandler func(err error)
+
+	p        *Pipedrive
+	store    ChangeFeedCheckpointStore
+	interval time.Duration
+	items    []RecentsItem
+
+	mu     sync.RWMutex
+	routes []changeRoute
+}
+
+// Creates change feed of the given items. No items means all items.
+// Non-positive interval defaults to a minute.
+func (p *Pipedrive) NewChangeFeed(store ChangeFeedCheckpointStore, interval time.Duration, items ...RecentsItem) *ChangeFeed {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	return &ChangeFeed{
+		p:        p,
+		store:    store,
+		interval: interval,
+		items:    items,
+	}
+}
+
+// Registers a handler for the changes of the item
+func (f *ChangeFeed) On(item RecentsItem, fn ChangeFunc) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.routes = append(f.routes, changeRoute{item: item, fn: fn})
+}
+
+// Registers a handler for all the changes
+func (f *ChangeFeed) OnAny(fn ChangeFunc) {
+	f.On("", fn)
+}
+
+// Registers a handler for changed deals
+func (f *ChangeFeed) OnDeal(fn func(ctx context.Context, deal Deal) error) {
+	f.On(RecentsDeal, func(ctx context.Context, c RecentChange) error {
+		var deal Deal
+		err := c.Decode(&deal)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, deal)
+	})
+}
+
+// Registers a handler for changed persons
+func (f *ChangeFeed) OnPerson(fn func(ctx context.Context, person Person) error) {
+	f.On(RecentsPerson, func(ctx context.Context, c RecentChange) error {
+		var person Person
+		err := c.Decode(&person)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, person)
+	})
+}
+
+// Registers a handler for changed organizations
+func (f *ChangeFeed) OnOrganization(fn func(ctx context.Context, org Organization) error) {
+	f.On(RecentsOrganization, func(ctx context.Context, c RecentChange) error {
+		var org Organization
+		err := c.Decode(&org)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, org)
+	})
+}
+
+// Registers a handler for changed products
+func (f *ChangeFeed) OnProduct(fn func(ctx context.Context, product Product) error) {
+	f.On(RecentsProduct, func(ctx context.Context, c RecentChange) error {
+		var product Product
+		err := c.Decode(&product)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, product)
+	})
+}
+
+// Registers a handler for changed notes
+func (f *ChangeFeed) OnNote(fn func(ctx context.Context, note Note) error) {
+	f.On(RecentsNote, func(ctx context.Context, c RecentChange) error {
+		var note Note
+		err := c.Decode(&note)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, note)
+	})
+}
+
+// Registers a handler for changed files
+func (f *ChangeFeed) OnFile(fn func(ctx context.Context, file File) error) {
+	f.On(RecentsFile, func(ctx context.Context, c RecentChange) error {
+		var file File
+		err := c.Decode(&file)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, file)
+	})
+}
+
+func (f *ChangeFeed) dispatch(ctx context.Context, c RecentChange) error {
+	f.mu.RLock()
+	routes := make([]changeRoute, len(f.routes))
+	copy(routes, f.routes)
+	f.mu.RUnlock()
+
+	for _, route := range routes {
+		if route.item != "" && route.item != c.Item {
+			continue
+		}
+
+		err := route.fn(ctx, c)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Processes the changes made since the checkpoint
+//
+// The checkpoint is advanced once all the pages are processed.
+func (f *ChangeFeed) Poll(ctx context.Context) error {
+	since, err := f.store.Load(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	if since == "" {
+		since = f.InitialTimestamp
+	}
+
+	if since == "" {
+		since = RecentsTimestamp(time.Now())
+		return f.store.Save(ctx, since)
+	}
+
+	last := since
+	start := 0
+	for {
+		err = ctx.Err()
+		if err != nil {
+			return err
+		}
+
+		pd_resp, err := f.p.ListRecents(since, RecentsOptions{Items: f.items, Start: start, Limit: 500})
+
+		if err != nil {
+			return err
+		}
+
+		// No changes
+		if pd_resp.Status < 400 && pd_resp.Data == nil {
+			break
+		}
+
+		var changes []RecentChange
+		err = pd_resp.DecodeData(&changes)
+
+		if err != nil {
+			return err
+		}
+
+		for _, change := range changes {
+			err = f.dispatch(ctx, change)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Timestamps of the same format are ordered as strings
+		page_last, _ := pd_resp.AdditionalData["last_timestamp_on_page"].(string)
+		if page_last > last {
+			last = page_last
+		}
+
+		next, more := pd_resp.NextStart()
+		if !more {
+			break
+		}
+		start = next
+	}
+
+	if last == since {
+		return nil
+	}
+
+	return f.store.Save(ctx, last)
+}
+
+// Polls recents every interval until ctx is cancelled
+func (f *ChangeFeed) Run(ctx context.Context) error {
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		err := f.Poll(ctx)
+
+		if err != nil && ctx.Err() == nil {
+			if f.ErrorHandler == nil {
+				return err
+			}
+
+			f.ErrorHandler(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package pipedrive
+
+import (
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Max size of a message posted by Pipedrive, including attachments
+const channelMaxMessageSize = 32 << 20
+
+// Options of the conversations requested by Pipedrive
+type ChannelConversationsQuery struct {
+	// Max number of conversations to return
+	ConversationsLimit int
+
+	// Max number of messages to include into every conversation
+	MessagesLimit int
+
+	// Cursor returned with the previous page
+	After string
+}
+
+// Options of the conversation messages requested by Pipedrive
+type ChannelMessagesQuery struct {
+	// Max number of messages to return
+	MessagesLimit int
+
+	// Cursor of the older messages (ChannelConversation.NextMessagesCursor)
+	After string
+}
+
+// Message sent by a Pipedrive user
+type ChannelOutgoingMessage struct {
+	ConversationId string
+	Message        string
+	Attachments    []*multipart.FileHeader
+}
+
+// Message delivered by the provider
+type ChannelSentMessage struct {
+	Id        string `json:"id"`
+	CreatedAt string `json:"created_at"`
+}
+
+// Messaging app implementation called by Pipedrive
+type ChannelProvider interface {
+	// Returns conversations of the channel and the cursor of the next page.
+	// Empty cursor means there are no more conversations.
+	GetConversations(ctx context.Context, channelId string, q ChannelConversationsQuery) ([]ChannelConversation, string, error)
+
+	// Returns a single conversation with its messages
+	GetConversation(ctx context.Context, channelId string, conversationId string, q ChannelMessagesQuery) (*ChannelConversation, error)
+
+	// Sends a message written in Pipedrive to the provider
+	PostMessage(ctx context.Context, channelId string, msg ChannelOutgoingMessage) (*ChannelSentMessage, error)
+}
+
+// HTTP handler of the messaging app callbacks
+//
+// Serves the endpoints declared in the app manifest and delegates them to
+// the provider:
+//
+//	GET  .../channels/{channelId}/conversations                   getConversations
+//	GET  .../channels/{channelId}/conversations/{conversationId}  getConversationById
+//	POST .../channels/{channelId}/messages                        postMessage
+//
+// The handler can be mounted under any prefix.
+type ChannelHandler struct {
+	provider ChannelProvider
+	user     string
+	password string
+}
+
+// Creates messaging app handler.
+//
+// Requests must carry the basic auth credentials of the app manifest.
+// If user is empty the credentials are not checked.
+func NewChannelHandler(provider ChannelProvider, user string, password string) *ChannelHandler {
+	return &ChannelHandler{provider: provider, user: user, password: password}
+}
+
+func (h *ChannelHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !checkBasicAuth(r, h.user, h.password) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="pipedrive"`)
+		writeChannelError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	// Find the last "channels" segment, so any prefix is allowed
+	start := -1
+	for idx := len(parts) - 2; idx >= 0; idx-- {
+		if parts[idx] == "channels" {
+			start = idx
+			break
+		}
+	}
+
+	if start < 0 {
+		writeChannelError(w, http.StatusNotFound, "Not found")
+		return
+	}
+
+	channelId := parts[start+1]
+	rest := parts[start+2:]
+
+	switch {
+	case len(rest) == 1 && rest[0] == "conversations" && r.Method == http.MethodGet:
+		h.getConversations(w, r, channelId)
+	case len(rest) == 2 && rest[0] == "conversations" && r.Method == http.MethodGet:
+		h.getConversation(w, r, channelId, rest[1])
+	case len(rest) == 1 && rest[0] == "messages" && r.Method == http.MethodPost:
+		h.postMessage(w, r, channelId)
+	default:
+		writeChannelError(w, http.StatusNotFound, "Not found")
+	}
+}
+
+func (h *ChannelHandler) getConversations(w http.ResponseWriter, r *http.Request, channelId string) {
+	query := r.URL.Query()
+	q := ChannelConversationsQuery{After: query.Get("after")}
+	q.ConversationsLimit, _ = strconv.Atoi(query.Get("conversations_limit"))
+	q.MessagesLimit, _ = strconv.Atoi(query.Get("messages_limit"))
+
+	conversations, after, err := h.provider.GetConversations(r.Context(), channelId, q)
+
+	if err != nil {
+		writeChannelError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if conversations == nil {
+		conversations = []ChannelConversation{}
+	}
+
+	resp := map[string]interface{}{
+		"success": true,
+		"data":    conversations,
+	}
+
+	if after != "" {
+		resp["additional_data"] = map[string]interface{}{"after": after}
+	}
+
+	writeChannelJson(w, http.StatusOK, resp)
+}
+
+func (h *ChannelHandler) getConversation(w http.ResponseWriter, r *http.Request, channelId string, conversationId string) {
+	query := r.URL.Query()
+	q := ChannelMessagesQuery{After: query.Get("after")}
+	q.MessagesLimit, _ = strconv.Atoi(query.Get("messages_limit"))
+
+	conversation, err := h.provider.GetConversation(r.Context(), channelId, conversationId, q)
+
+	if err != nil {
+		writeChannelError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if conversation == nil {
+		writeChannelError(w, http.StatusNotFound, "Conversation not found")
+		return
+	}
+
+	writeChannelJson(w, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"data":    conversation,
+	})
+}
+
+func (h *ChannelHandler) postMessage(w http.ResponseWriter, r *http.Request, channelId string) {
+	r.Body = http.MaxBytesReader(w, r.Body, channelMaxMessageSize)
+	err := r.ParseMultipartForm(channelMaxMessageSize)
+
+	if err != nil && err != http.ErrNotMultipart {
+		writeChannelError(w, http.StatusBadRequest, "Malformed message")
+		return
+	}
+
+	msg := ChannelOutgoingMessage{
+		ConversationId: r.FormValue("conversationId"),
+		Message:        r.FormValue("message"),
+	}
+
+	if r.MultipartForm != nil {
+		msg.Attachments = r.MultipartForm.File["attachments"]
+	}
+
+	if msg.ConversationId == "" {
+		writeChannelError(w, http.StatusBadRequest, "Conversation id is required")
+		return
+	}
+
+	sent, err := h.provider.PostMessage(r.Context(), channelId, msg)
+
+	if err != nil {
+		writeChannelError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeChannelJson(w, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"data":    sent,
+	})
+}
+
+func writeChannelJson(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("content-type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeChannelError(w http.ResponseWriter, status int, msg string) {
+	writeChannelJson(w, status, map[string]interface{}{
+		"success": false,
+		"error":   msg,
+	})
+}
@@ -0,0 +1,262 @@
+package pipedrive
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Type of a messaging channel provider
+type ChannelProviderType string
+
+const (
+	ChannelProviderFacebook ChannelProviderType = "facebook"
+	ChannelProviderWhatsApp ChannelProviderType = "whatsapp"
+	ChannelProviderOther    ChannelProviderType = "other"
+)
+
+// Messaging channel.
+//
+// The same model is used to add channels, read-only fields are omitted when empty.
+type Channel struct {
+	Id                string              `json:"id,omitempty"`
+	Name              string              `json:"name"`
+	ProviderChannelId string              `json:"provider_channel_id"`
+	AvatarUrl         string              `json:"avatar_url,omitempty"`
+	TemplateSupport   bool                `json:"template_support,omitempty"`
+	ProviderType      ChannelProviderType `json:"provider_type,omitempty"`
+
+	// Read only fields
+	MarketplaceClientId string `json:"marketplace_client_id,omitempty"`
+	CompanyId           int    `json:"pd_company_id,omitempty"`
+	UserId              int    `json:"pd_user_id,omitempty"`
+	CreatedAt           string `json:"created_at,omitempty"`
+}
+
+// Status of a message
+type ChannelMessageStatus string
+
+const (
+	ChannelMessageSent      ChannelMessageStatus = "sent"
+	ChannelMessageDelivered ChannelMessageStatus = "delivered"
+	ChannelMessageRead      ChannelMessageStatus = "read"
+	ChannelMessageFailed    ChannelMessageStatus = "failed"
+)
+
+// File attached to a message
+type ChannelAttachment struct {
+	Id   string `json:"id"`
+	Name string `json:"name,omitempty"`
+
+	// Mime type of the attachment
+	Type       string `json:"type"`
+	Size       int64  `json:"size,omitempty"`
+	Url        string `json:"url"`
+	PreviewUrl string `json:"preview_url,omitempty"`
+
+	// Whether the url expires and has to be fetched again
+	LinkExpires bool `json:"link_expires,omitempty"`
+}
+
+// Message of a conversation
+type ChannelMessage struct {
+	Id       string               `json:"id"`
+	SenderId string               `json:"sender_id"`
+	Message  string               `json:"message"`
+	Status   ChannelMessageStatus `json:"status"`
+
+	// Creation date and time of the message in ISO-8601 format
+	CreatedAt string `json:"created_at"`
+
+	// Date and time until which the message can be replied, in ISO-8601 format
+	ReplyBy string `json:"reply_by,omitempty"`
+
+	Attachments []ChannelAttachment `json:"attachments,omitempty"`
+
+	// Set only for the messages sent to Pipedrive with ReceiveChannelMessage
+	ChannelId        string `json:"channel_id,omitempty"`
+	ConversationId   string `json:"conversation_id,omitempty"`
+	ConversationLink string `json:"conversation_link,omitempty"`
+}
+
+// Role of a conversation participant
+type ChannelParticipantRole string
+
+const (
+	// The user of the Pipedrive company
+	ChannelParticipantSourceUser ChannelParticipantRole = "source_user"
+
+	// The contact the company is talking to
+	ChannelParticipantEndUser ChannelParticipantRole = "end_user"
+)
+
+// Participant of a conversation
+type ChannelParticipant struct {
+	Id        string                 `json:"id"`
+	Name      string                 `json:"name"`
+	Role      ChannelParticipantRole `json:"role"`
+	AvatarUrl string                 `json:"avatar_url,omitempty"`
+
+	// Whether Pipedrive should fetch the avatar and keep its own copy
+	FetchAvatar    bool `json:"fetch_avatar,omitempty"`
+	AvatarExpires  bool `json:"avatar_expires,omitempty"`
+	SenderIdMasked bool `json:"sender_id_masked,omitempty"`
+}
+
+// Status of a conversation
+type ChannelConversationStatus string
+
+const (
+	ChannelConversationOpen   ChannelConversationStatus = "open"
+	ChannelConversationClosed ChannelConversationStatus = "closed"
+)
+
+// Conversation of a messaging channel
+type ChannelConversation struct {
+	Id     string                    `json:"id"`
+	Link   string                    `json:"link,omitempty"`
+	Status ChannelConversationStatus `json:"status"`
+	Seen   bool                      `json:"seen"`
+
+	// Cursor of the older messages, empty if all messages are included
+	NextMessagesCursor string `json:"next_messages_cursor,omitempty"`
+
+	Messages     []ChannelMessage     `json:"messages"`
+	Participants []ChannelParticipant `json:"participants"`
+}
+
+// Add a channel
+//
+// Adds a new messaging channel, only admins are able to register new channels.
+// Name and ProviderChannelId are required.
+//
+// https://developers.pipedrive.com/docs/api/v1/Channels#addChannel
+func (p *Pipedrive) AddChannel(ch Channel) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("channels")
+
+	if ch.Name == "" {
+		return nil, errors.New("Channel name is required")
+	}
+
+	if ch.ProviderChannelId == "" {
+		return nil, errors.New("Provider channel id is required")
+	}
+
+	body := Channel{
+		Name:              ch.Name,
+		ProviderChannelId: ch.ProviderChannelId,
+		AvatarUrl:         ch.AvatarUrl,
+		TemplateSupport:   ch.TemplateSupport,
+		ProviderType:      ch.ProviderType,
+	}
+
+	json_data, err := json.Marshal(body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete a channel
+//
+// Deletes an existing messenger's channel and all related entities
+// (conversations and messages).
+//
+// https://developers.pipedrive.com/docs/api/v1/Channels#deleteChannel
+func (p *Pipedrive) DeleteChannel(id string) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("channels/%s", id)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Receives an incoming message
+//
+// Adds a message to a conversation. Id, ChannelId, SenderId, ConversationId,
+// Message, Status and CreatedAt are required.
+//
+// https://developers.pipedrive.com/docs/api/v1/Channels#receiveMessage
+func (p *Pipedrive) ReceiveChannelMessage(msg ChannelMessage) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("channels/messages/receive")
+
+	if msg.Id == "" || msg.ChannelId == "" || msg.ConversationId == "" {
+		return nil, errors.New("Message id, channel id and conversation id are required")
+	}
+
+	if msg.SenderId == "" {
+		return nil, errors.New("Sender id is required")
+	}
+
+	if msg.Status == "" || msg.CreatedAt == "" {
+		return nil, errors.New("Message status and creation time are required")
+	}
+
+	json_data, err := json.Marshal(msg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete a conversation
+//
+// Deletes an existing conversation.
+//
+// https://developers.pipedrive.com/docs/api/v1/Channels#deleteConversation
+func (p *Pipedrive) DeleteChannelConversation(channelId string, conversationId string) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("channels/%s/conversations/%s", channelId, conversationId)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
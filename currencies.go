@@ -0,0 +1,212 @@
+package pipedrive
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+)
+
+type Currency struct {
+	Id            int    `json:"id"`
+	Code          string `json:"code"`
+	Name          string `json:"name"`
+	DecimalPoints int    `json:"decimal_points"`
+	Symbol        string `json:"symbol"`
+	ActiveFlag    bool   `json:"active_flag"`
+	IsCustomFlag  bool   `json:"is_custom_flag"`
+}
+
+// Get all supported currencies
+//
+// Returns all supported currencies in given account which should be used
+// when saving monetary values with other objects. The code parameter of the
+// returning objects is the currency code according to ISO 4217 for all
+// non-custom currencies. If term is not empty only currencies with the
+// name or code matching the term are returned.
+//
+// https://developers.pipedrive.com/docs/api/v1/Currencies#getCurrencies
+func (p *Pipedrive) ListCurrencies(term string) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("currencies")
+
+	if term != "" {
+		url.Query.Add("term", term)
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Source of the exchange rates.
+//
+// Pipedrive does not expose exchange rates, so they have to come from
+// elsewhere. Returns the rates relative to a base currency: the amount of
+// the currency one unit of the base currency buys. The base currency itself
+// may be omitted.
+type CurrencyRatesSource func() (base string, rates map[string]float64, err error)
+
+// Converts monetary amounts between the currencies of the company.
+//
+// The rates are loaded once, create a new converter to refresh them.
+// Converted amounts are rounded to the decimal points of the target currency.
+type CurrencyConverter struct {
+	base       string
+	rates      map[string]float64
+	currencies map[string]Currency
+}
+
+// Loads currency converter
+//
+// Loads the currencies of the company (for their decimal points) and the
+// exchange rates from the source.
+func (p *Pipedrive) LoadCurrencyConverter(source CurrencyRatesSource) (*CurrencyConverter, error) {
+	if source == nil {
+		return nil, errors.New("Rates source is required")
+	}
+
+	pd_resp, err := p.ListCurrencies("")
+
+	if err != nil {
+		return nil, err
+	}
+
+	var currencies []Currency
+	err = pd_resp.DecodeData(&currencies)
+
+	if err != nil {
+		return nil, err
+	}
+
+	base, rates, err := source()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCurrencyConverter(base, rates, currencies)
+}
+
+// Creates currency converter from known rates and currencies
+func NewCurrencyConverter(base string, rates map[string]float64, currencies []Currency) (*CurrencyConverter, error) {
+	if base == "" {
+		return nil, errors.New("Base currency is required")
+	}
+
+	c := &CurrencyConverter{
+		base:       strings.ToUpper(base),
+		rates:      map[string]float64{},
+		currencies: map[string]Currency{},
+	}
+
+	for code, rate := range rates {
+		if rate <= 0 {
+			return nil, fmt.Errorf("Invalid rate of %s: %v", code, rate)
+		}
+
+		c.rates[strings.ToUpper(code)] = rate
+	}
+
+	c.rates[c.base] = 1
+
+	for _, currency := range currencies {
+		c.currencies[strings.ToUpper(currency.Code)] = currency
+	}
+
+	return c, nil
+}
+
+// Returns the currency by its code
+func (c *CurrencyConverter) Currency(code string) (Currency, bool) {
+	currency, ok := c.currencies[strings.ToUpper(code)]
+	return currency, ok
+}
+
+// Returns decimal points of the currency.
+// Unknown currencies have 2 decimal points.
+func (c *CurrencyConverter) DecimalPoints(code string) int {
+	currency, ok := c.Currency(code)
+	if !ok {
+		return 2
+	}
+
+	return currency.DecimalPoints
+}
+
+// Rounds amount to the decimal points of the currency
+func (c *CurrencyConverter) Round(amount float64, code string) float64 {
+	scale := math.Pow(10, float64(c.DecimalPoints(code)))
+	return math.Round(amount*scale) / scale
+}
+
+// Formats amount with the decimal points and the symbol of the currency
+func (c *CurrencyConverter) Format(amount float64, code string) string {
+	value := fmt.Sprintf("%.*f", c.DecimalPoints(code), c.Round(amount, code))
+
+	currency, ok := c.Currency(code)
+	if ok && currency.Symbol != "" {
+		return fmt.Sprintf("%s %s", value, currency.Symbol)
+	}
+
+	return fmt.Sprintf("%s %s", value, strings.ToUpper(code))
+}
+
+// Converts amount from one currency into another
+func (c *CurrencyConverter) Convert(amount float64, from string, to string) (float64, error) {
+	from = strings.ToUpper(from)
+	to = strings.ToUpper(to)
+
+	if from == to {
+		return c.Round(amount, to), nil
+	}
+
+	from_rate, ok := c.rates[from]
+	if !ok {
+		return 0, fmt.Errorf("No exchange rate of %s", from)
+	}
+
+	to_rate, ok := c.rates[to]
+	if !ok {
+		return 0, fmt.Errorf("No exchange rate of %s", to)
+	}
+
+	return c.Round(amount/from_rate*to_rate, to), nil
+}
+
+// Converts value of the deal
+func (c *CurrencyConverter) ConvertDeal(deal Deal, to string) (float64, error) {
+	return c.Convert(deal.Value, deal.Currency, to)
+}
+
+// Converts value of the lead. Leads without value are worth 0.
+func (c *CurrencyConverter) ConvertLead(lead Lead, to string) (float64, error) {
+	if lead.Value == nil {
+		return 0, nil
+	}
+
+	return c.Convert(lead.Value.Amount, lead.Value.Currency, to)
+}
+
+// Converts price of the product.
+//
+// The price in the target currency is used as is when the product has one,
+// otherwise the first price is converted.
+func (c *CurrencyConverter) ConvertProduct(product Product, to string) (float64, error) {
+	price, ok := product.Price(strings.ToUpper(to))
+	if ok {
+		return c.Round(price.Price, to), nil
+	}
+
+	if len(product.Prices) < 1 {
+		return 0, errors.New("Product has no prices")
+	}
+
+	price = product.Prices[0]
+	return c.Convert(price.Price, price.Currency, to)
+}
@@ -1,10 +1,56 @@
 package pipedrive
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 )
 
+// Deal.
+//
+// Fields are decoded from both API v1 and v2 payloads (including webhooks),
+// related entities are decoded whether they are sent as ids or objects.
+type Deal struct {
+	Id       int     `json:"id"`
+	Title    string  `json:"title"`
+	Value    float64 `json:"value"`
+	Currency string  `json:"currency"`
+	Status   string  `json:"status"`
+
+	StageId    int `json:"stage_id"`
+	PipelineId int `json:"pipeline_id"`
+
+	Person       IdRef `json:"person_id"`
+	Organization IdRef `json:"org_id"`
+
+	// Owner of the deal. API v1 calls it user_id, API v2 owner_id.
+	User  IdRef `json:"user_id"`
+	Owner IdRef `json:"owner_id"`
+
+	Probability       *float64 `json:"probability"`
+	ExpectedCloseDate string   `json:"expected_close_date"`
+	LostReason        string   `json:"lost_reason"`
+	AddTime           string   `json:"add_time"`
+	UpdateTime        string   `json:"update_time"`
+	StageChangeTime   string   `json:"stage_change_time"`
+	WonTime           string   `json:"won_time"`
+	LostTime          string   `json:"lost_time"`
+	CloseTime         string   `json:"close_time"`
+}
+
+// Returns id of the deal owner regardless of the API version
+func (d Deal) OwnerId() int {
+	if d.Owner.Id > 0 {
+		return d.Owner.Id
+	}
+
+	return d.User.Id
+}
+
 type DealFilterStatus int
 
 const (
@@ -74,3 +120,280 @@ func (p *Pipedrive) ListDeals(f DealsFilter) (*PipedriveResponse, error) {
 	pd_resp := p.readResponse(resp)
 	return pd_resp, nil
 }
+
+// Add a deal
+//
+// Adds a new deal. Note that you can supply additional custom fields along with
+// the request that are not described here. These custom fields are different for
+// each Pipedrive account and can be recognized by long hashes as keys.
+//
+// https://developers.pipedrive.com/docs/api/v1/Deals#addDeal
+func (p *Pipedrive) AddDeal(fields map[string]interface{}) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("deals")
+
+	_, t_ok := fields["title"]
+	if !t_ok {
+		return nil, errors.New("Field 'title' is required")
+	}
+
+	json_data, err := json.Marshal(fields)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get details of a deal
+//
+// Returns the details of a specific deal.
+//
+// https://developers.pipedrive.com/docs/api/v1/Deals#getDeal
+func (p *Pipedrive) GetDeal(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("deals/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+type DiscountType string
+
+const (
+	DiscountTypePercentage DiscountType = "percentage"
+	DiscountTypeAmount     DiscountType = "amount"
+)
+
+type TaxMethod string
+
+const (
+	TaxMethodExclusive TaxMethod = "exclusive"
+	TaxMethodInclusive TaxMethod = "inclusive"
+	TaxMethodNone      TaxMethod = "none"
+)
+
+// Product attached to a deal
+type DealProduct struct {
+	// Id of the product attachment
+	Id     int `json:"id,omitempty"`
+	DealId int `json:"deal_id,omitempty"`
+
+	ProductId          int     `json:"product_id,omitempty"`
+	ProductVariationId int     `json:"product_variation_id,omitempty"`
+	ItemPrice          float64 `json:"item_price"`
+	Quantity           float64 `json:"quantity"`
+
+	Discount     float64      `json:"discount,omitempty"`
+	DiscountType DiscountType `json:"discount_type,omitempty"`
+
+	// The duration of the product. If omitted, will be set to 1.
+	Duration     float64 `json:"duration,omitempty"`
+	DurationUnit string  `json:"duration_unit,omitempty"`
+
+	Tax         float64   `json:"tax,omitempty"`
+	TaxMethod   TaxMethod `json:"tax_method,omitempty"`
+	Comments    string    `json:"comments,omitempty"`
+	EnabledFlag *bool     `json:"enabled_flag,omitempty"`
+
+	// Read only fields
+	Name     string   `json:"name,omitempty"`
+	Currency string   `json:"currency,omitempty"`
+	Sum      float64  `json:"sum,omitempty"`
+	Product  *Product `json:"product,omitempty"`
+}
+
+// Prepares the product to be attached to a deal.
+//
+// Item price is taken from the product price in the deal currency
+// and the product tax is applied.
+func (p Product) ForDeal(currency string, quantity float64) (DealProduct, error) {
+	price, ok := p.Price(currency)
+	if !ok {
+		msg := fmt.Sprintf("Product %d has no price in %s", p.Id, currency)
+		return DealProduct{}, errors.New(msg)
+	}
+
+	return DealProduct{
+		ProductId: p.Id,
+		ItemPrice: price.Price,
+		Quantity:  quantity,
+		Tax:       p.Tax,
+	}, nil
+}
+
+type DealProductsFilter struct {
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+
+	// Whether to fetch product data along with each attached product
+	IncludeProductData bool
+}
+
+// List products attached to a deal
+//
+// https://developers.pipedrive.com/docs/api/v1/Deals#getDealProducts
+func (p *Pipedrive) ListDealProducts(id int, f DealProductsFilter) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("deals/%d/products", id)
+	url := p.makeApiEndpoint(ep)
+
+	if f.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(f.Start))
+	}
+
+	if f.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(f.Limit))
+	}
+
+	if f.IncludeProductData == true {
+		url.Query.Add("include_product_data", "1")
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Returns attachment with read only fields cleared
+func (dp DealProduct) attachment() DealProduct {
+	dp.Id = 0
+	dp.DealId = 0
+	dp.Name = ""
+	dp.Currency = ""
+	dp.Sum = 0
+	dp.Product = nil
+	return dp
+}
+
+// Changes of a product attached to a deal. Nil and empty values are not sent,
+// so the fields which are not set are left untouched.
+type DealProductUpdate struct {
+	ProductId          int      `json:"product_id,omitempty"`
+	ProductVariationId int      `json:"product_variation_id,omitempty"`
+	ItemPrice          *float64 `json:"item_price,omitempty"`
+	Quantity           *float64 `json:"quantity,omitempty"`
+
+	Discount     *float64     `json:"discount,omitempty"`
+	DiscountType DiscountType `json:"discount_type,omitempty"`
+
+	Duration     *float64 `json:"duration,omitempty"`
+	DurationUnit string   `json:"duration_unit,omitempty"`
+
+	Tax         *float64  `json:"tax,omitempty"`
+	TaxMethod   TaxMethod `json:"tax_method,omitempty"`
+	Comments    *string   `json:"comments,omitempty"`
+	EnabledFlag *bool     `json:"enabled_flag,omitempty"`
+}
+
+// Add a product to a deal
+//
+// Adds a product to a deal, creating a new item called a deal-product.
+//
+// https://developers.pipedrive.com/docs/api/v1/Deals#addDealProduct
+func (p *Pipedrive) AddDealProduct(id int, product DealProduct) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("deals/%d/products", id)
+	url := p.makeApiEndpoint(ep)
+
+	if product.ProductId <= 0 {
+		return nil, errors.New("Product id is required")
+	}
+
+	json_data, err := json.Marshal(product.attachment())
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Update the product attached to a deal
+//
+// Only the fields set in the update are changed.
+//
+// https://developers.pipedrive.com/docs/api/v1/Deals#updateDealProduct
+func (p *Pipedrive) UpdateDealProduct(id int, attachmentId int, update DealProductUpdate) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("deals/%d/products/%d", id, attachmentId)
+	url := p.makeApiEndpoint(ep)
+
+	json_data, err := json.Marshal(update)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	req, err := http.NewRequest("PUT", url.String(), buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete an attached product from a deal
+//
+// https://developers.pipedrive.com/docs/api/v1/Deals#deleteDealProduct
+func (p *Pipedrive) DeleteDealProduct(id int, attachmentId int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("deals/%d/products/%d", id, attachmentId)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
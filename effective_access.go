@@ -0,0 +1,108 @@
+package pipedrive
+
+// Access a user ends up with
+type EffectiveAccess struct {
+	User User
+
+	// Role the user is assigned to. Zero when the user has no role.
+	RoleId int
+
+	// Pipelines visible to the user ordered by order_nr
+	Pipelines []Pipeline
+
+	// Permission sets assigned to the user, one per app
+	PermissionSets []PermissionSet
+}
+
+// Returns true if the pipeline is visible to the user
+func (a EffectiveAccess) CanSeePipeline(id int) bool {
+	for _, pipeline := range a.Pipelines {
+		if pipeline.Id == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Get effective access of a user
+//
+// Computes which pipelines and permission sets the user ends up with.
+// Admins and users without a role see all the pipelines, otherwise pipeline
+// visibility is taken from the role of the user. Permission sets are taken
+// from the user's access to the apps.
+func (p *Pipedrive) GetEffectiveAccess(userId int) (*EffectiveAccess, error) {
+	pd_resp, err := p.GetUser(userId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var user User
+	err = pd_resp.DecodeData(&user)
+
+	if err != nil {
+		return nil, err
+	}
+
+	access := &EffectiveAccess{User: user, RoleId: user.RoleId}
+
+	topology, err := p.LoadPipelineTopology()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsAdmin == 1 || user.RoleId == 0 {
+		for _, pipeline := range topology.Pipelines {
+			access.Pipelines = append(access.Pipelines, pipeline.Pipeline)
+		}
+	} else {
+		pd_resp, err = p.ListRolePipelines(user.RoleId, true)
+
+		if err != nil {
+			return nil, err
+		}
+
+		var visible RolePipelines
+		err = pd_resp.DecodeData(&visible)
+
+		if err != nil {
+			return nil, err
+		}
+
+		ids := map[int]bool{}
+		for _, id := range visible.PipelineIds {
+			ids[id] = true
+		}
+
+		for _, pipeline := range topology.Pipelines {
+			if ids[pipeline.Id] {
+				access.Pipelines = append(access.Pipelines, pipeline.Pipeline)
+			}
+		}
+	}
+
+	for _, app := range user.Access {
+		if app.PermissionSetId == "" {
+			continue
+		}
+
+		pd_resp, err = p.GetPermissionSet(app.PermissionSetId)
+
+		if err != nil {
+			return nil, err
+		}
+
+		var set PermissionSet
+		err = pd_resp.DecodeData(&set)
+
+		if err != nil {
+			return nil, err
+		}
+
+		access.PermissionSets = append(access.PermissionSets, set)
+	}
+
+	return access, nil
+}
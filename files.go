@@ -0,0 +1,377 @@
+package pipedrive
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	NetUrl "net/url"
+	"strconv"
+	"strings"
+)
+
+type File struct {
+	Id             int    `json:"id"`
+	UserId         int    `json:"user_id"`
+	DealId         int    `json:"deal_id"`
+	PersonId       int    `json:"person_id"`
+	OrgId          int    `json:"org_id"`
+	ProductId      int    `json:"product_id"`
+	ActivityId     int    `json:"activity_id"`
+	LeadId         string `json:"lead_id"`
+	AddTime        string `json:"add_time"`
+	UpdateTime     string `json:"update_time"`
+	FileName       string `json:"file_name"`
+	FileType       string `json:"file_type"`
+	FileSize       int64  `json:"file_size"`
+	ActiveFlag     bool   `json:"active_flag"`
+	InlineFlag     bool   `json:"inline_flag"`
+	RemoteLocation string `json:"remote_location"`
+	RemoteId       string `json:"remote_id"`
+	DealName       string `json:"deal_name"`
+	PersonName     string `json:"person_name"`
+	OrgName        string `json:"org_name"`
+	ProductName    string `json:"product_name"`
+	Url            string `json:"url"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+}
+
+type FilesFilter struct {
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+
+	// When enabled, the list of files will also include deleted files.
+	// Please note that trying to download these files will not work.
+	IncludeDeleted *IncludeDeletedFiles
+
+	// The field names and sorting mode separated by a comma (field_name_1 ASC,
+	// field_name_2 DESC). Only first-level field keys are supported (no nested keys).
+	// Supported fields: id, user_id, deal_id, person_id, org_id, product_id,
+	// add_time, update_time, file_name, file_type, file_size, comment.
+	Sort string
+}
+
+// Get all files
+//
+// Returns data about all files.
+//
+// https://developers.pipedrive.com/docs/api/v1/Files#getFiles
+func (p *Pipedrive) ListFiles(f FilesFilter) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("files")
+
+	if f.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(f.Start))
+	}
+
+	if f.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(f.Limit))
+	}
+
+	if f.IncludeDeleted != nil {
+		url.Query.Add("include_deleted_files", f.IncludeDeleted.String())
+	}
+
+	if f.Sort != "" {
+		url.Query.Add("sort", f.Sort)
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get one file
+//
+// Returns data about a specific file.
+//
+// https://developers.pipedrive.com/docs/api/v1/Files#getFile
+func (p *Pipedrive) GetFile(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("files/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Download one file
+//
+// Returns the content of the file. The caller is responsible for closing it.
+//
+// https://developers.pipedrive.com/docs/api/v1/Files#downloadFile
+func (p *Pipedrive) DownloadFile(id int) (io.ReadCloser, error) {
+	ep := fmt.Sprintf("files/%d/download", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 400 {
+		pd_resp := p.readResponse(resp)
+		msg := pd_resp.ErrorMsg
+		if msg == "" {
+			msg = resp.Status
+		}
+		return nil, errors.New(msg)
+	}
+
+	return resp.Body, nil
+}
+
+// Items the uploaded file is attached to
+//
+// Pipedrive does not attach files to notes, the files endpoint accepts no
+// note id. To reference a file from a note, upload it attached to the note's
+// deal, person, organization or lead and link it in the note content.
+type FileAttachment struct {
+	DealId     int
+	PersonId   int
+	OrgId      int
+	ProductId  int
+	ActivityId int
+	LeadId     string
+}
+
+// Add file
+//
+// Uploads a file and associates it with a deal, person, organization,
+// activity, product or lead. The content is streamed from r as is,
+// the file is never buffered in memory as a whole.
+//
+// https://developers.pipedrive.com/docs/api/v1/Files#addFile
+func (p *Pipedrive) AddFile(name string, r io.Reader, attach FileAttachment) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("files")
+
+	if name == "" {
+		return nil, errors.New("File name is required")
+	}
+
+	fields := map[string]string{}
+
+	if attach.DealId > 0 {
+		fields["deal_id"] = strconv.Itoa(attach.DealId)
+	}
+
+	if attach.PersonId > 0 {
+		fields["person_id"] = strconv.Itoa(attach.PersonId)
+	}
+
+	if attach.OrgId > 0 {
+		fields["org_id"] = strconv.Itoa(attach.OrgId)
+	}
+
+	if attach.ProductId > 0 {
+		fields["product_id"] = strconv.Itoa(attach.ProductId)
+	}
+
+	if attach.ActivityId > 0 {
+		fields["activity_id"] = strconv.Itoa(attach.ActivityId)
+	}
+
+	if attach.LeadId != "" {
+		fields["lead_id"] = attach.LeadId
+	}
+
+	return p.postMultipart(url, fields, "file", name, r)
+}
+
+// Sends multipart form with a single file.
+//
+// The body is written through a pipe while the request is being sent,
+// so the content of r is not buffered.
+func (p *Pipedrive) postMultipart(url *PdEndpoint, fields map[string]string, fileField string, fileName string, r io.Reader) (*PipedriveResponse, error) {
+	pr, pw := io.Pipe()
+	form := multipart.NewWriter(pw)
+
+	go func() {
+		for key, val := range fields {
+			err := form.WriteField(key, val)
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+
+		part, err := form.CreateFormFile(fileField, fileName)
+		if err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+
+		_, err = io.Copy(part, r)
+		if err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+
+		pw.CloseWithError(form.Close())
+	}()
+
+	resp, err := http.Post(url.String(), form.FormDataContentType(), pr)
+
+	// Unblock the writer if the request failed before the body was consumed
+	pr.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Sends url encoded form
+func (p *Pipedrive) sendForm(method string, url *PdEndpoint, form NetUrl.Values) (*PipedriveResponse, error) {
+	req, err := http.NewRequest(method, url.String(), strings.NewReader(form.Encode()))
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", "application/x-www-form-urlencoded")
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Update file details
+//
+// Updates the properties of a file. Empty values are left untouched.
+//
+// https://developers.pipedrive.com/docs/api/v1/Files#updateFile
+func (p *Pipedrive) UpdateFile(id int, name string, description string) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("files/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	form := NetUrl.Values{}
+
+	if name != "" {
+		form.Add("name", name)
+	}
+
+	if description != "" {
+		form.Add("description", description)
+	}
+
+	return p.sendForm("PUT", url, form)
+}
+
+// Delete a file
+//
+// Marks a file as deleted. After 30 days, the file will be permanently deleted.
+//
+// https://developers.pipedrive.com/docs/api/v1/Files#deleteFile
+func (p *Pipedrive) DeleteFile(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("files/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Types of remote files
+type RemoteFileType string
+
+const (
+	RemoteFileTypeDoc    RemoteFileType = "gdoc"
+	RemoteFileTypeSlides RemoteFileType = "gslides"
+	RemoteFileTypeSheet  RemoteFileType = "gsheet"
+	RemoteFileTypeForm   RemoteFileType = "gform"
+	RemoteFileTypeDraw   RemoteFileType = "gdraw"
+)
+
+// Items remote files can be attached to
+type RemoteFileItemType string
+
+const (
+	RemoteFileItemDeal         RemoteFileItemType = "deal"
+	RemoteFileItemOrganization RemoteFileItemType = "organization"
+	RemoteFileItemPerson       RemoteFileItemType = "person"
+)
+
+// Only Google Drive is supported at the moment
+const RemoteLocationGoogleDrive = "googledrive"
+
+// Create a remote file and link it to an item
+//
+// Creates a new empty file in the remote location (googledrive) that will be
+// linked to the item you supply.
+//
+// https://developers.pipedrive.com/docs/api/v1/Files#addFileAndLinkIt
+func (p *Pipedrive) AddRemoteFile(fileType RemoteFileType, title string, itemType RemoteFileItemType, itemId int) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("files/remote")
+
+	if fileType == "" || title == "" || itemType == "" || itemId <= 0 {
+		return nil, errors.New("File type, title and item are required")
+	}
+
+	form := NetUrl.Values{}
+	form.Add("file_type", string(fileType))
+	form.Add("title", title)
+	form.Add("item_type", string(itemType))
+	form.Add("item_id", strconv.Itoa(itemId))
+	form.Add("remote_location", RemoteLocationGoogleDrive)
+
+	return p.sendForm("POST", url, form)
+}
+
+// Link a remote file to an item
+//
+// Links an existing remote file (googledrive) to the item you supply.
+//
+// https://developers.pipedrive.com/docs/api/v1/Files#linkFileToItem
+func (p *Pipedrive) LinkRemoteFile(remoteId string, itemType RemoteFileItemType, itemId int) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("files/remoteLink")
+
+	if remoteId == "" || itemType == "" || itemId <= 0 {
+		return nil, errors.New("Remote id and item are required")
+	}
+
+	form := NetUrl.Values{}
+	form.Add("item_type", string(itemType))
+	form.Add("item_id", strconv.Itoa(itemId))
+	form.Add("remote_id", remoteId)
+	form.Add("remote_location", RemoteLocationGoogleDrive)
+
+	return p.sendForm("POST", url, form)
+}
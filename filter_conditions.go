@@ -0,0 +1,135 @@
+package pipedrive
+
+import "encoding/json"
+
+// Objects the filter conditions are applied to
+type FilterObject string
+
+const (
+	FilterObjectDeal         FilterObject = "deal"
+	FilterObjectLead         FilterObject = "lead"
+	FilterObjectPerson       FilterObject = "person"
+	FilterObjectOrganization FilterObject = "organization"
+	FilterObjectProduct      FilterObject = "product"
+	FilterObjectActivity     FilterObject = "activity"
+)
+
+type FilterOperator string
+
+const (
+	FilterOpEqual          FilterOperator = "="
+	FilterOpNotEqual       FilterOperator = "!="
+	FilterOpLess           FilterOperator = "<"
+	FilterOpGreater        FilterOperator = ">"
+	FilterOpLessOrEqual    FilterOperator = "<="
+	FilterOpGreaterOrEqual FilterOperator = ">="
+	FilterOpIsNull         FilterOperator = "IS NULL"
+	FilterOpIsNotNull      FilterOperator = "IS NOT NULL"
+	FilterOpStartsWith     FilterOperator = "LIKE '$%'"
+	FilterOpEndsWith       FilterOperator = "LIKE '%$'"
+	FilterOpContains       FilterOperator = "LIKE '%$%'"
+	FilterOpNotContains    FilterOperator = "NOT LIKE '%$%'"
+)
+
+type FilterGlue string
+
+const (
+	FilterGlueAnd FilterGlue = "and"
+	FilterGlueOr  FilterGlue = "or"
+)
+
+// Single filter condition
+type FilterCondition struct {
+	Object FilterObject `json:"object"`
+
+	// The ID of the field as returned by the fields endpoints (dealFields, personFields, etc.)
+	FieldId int `json:"field_id,string"`
+
+	Operator FilterOperator `json:"operator"`
+
+	// Value to compare with. Leave nil for IS NULL and IS NOT NULL operators.
+	Value interface{} `json:"value"`
+
+	// Second value used by some of the date conditions
+	ExtraValue interface{} `json:"extra_value"`
+}
+
+// Group of conditions joined with the same glue
+type FilterConditionGroup struct {
+	Glue       FilterGlue        `json:"glue"`
+	Conditions []FilterCondition `json:"conditions"`
+}
+
+// Filter conditions builder.
+//
+// Pipedrive expects conditions as two groups joined with "and": the first
+// group must be matched as a whole (AND) and of the second group at least
+// one condition must be matched (OR). Empty groups are sent as well, they
+// are simply ignored by Pipedrive.
+//
+//	conditions := pipedrive.NewFilterConditions().
+//		Where(pipedrive.FilterObjectDeal, 12, pipedrive.FilterOpEqual, "open").
+//		OrWhere(pipedrive.FilterObjectDeal, 9, pipedrive.FilterOpGreater, 1000)
+type FilterConditions struct {
+	And []FilterCondition
+	Or  []FilterCondition
+}
+
+func NewFilterConditions() *FilterConditions {
+	return &FilterConditions{}
+}
+
+// Adds condition to the AND group
+func (c *FilterConditions) Where(object FilterObject, fieldId int, op FilterOperator, value interface{}) *FilterConditions {
+	c.And = append(c.And, FilterCondition{Object: object, FieldId: fieldId, Operator: op, Value: value})
+	return c
+}
+
+// Adds condition to the OR group
+func (c *FilterConditions) OrWhere(object FilterObject, fieldId int, op FilterOperator, value interface{}) *FilterConditions {
+	c.Or = append(c.Or, FilterCondition{Object: object, FieldId: fieldId, Operator: op, Value: value})
+	return c
+}
+
+// Adds already built condition to the AND group
+func (c *FilterConditions) AddAnd(cond ...FilterCondition) *FilterConditions {
+	c.And = append(c.And, cond...)
+	return c
+}
+
+// Adds already built condition to the OR group
+func (c *FilterConditions) AddOr(cond ...FilterCondition) *FilterConditions {
+	c.Or = append(c.Or, cond...)
+	return c
+}
+
+// Returns the AND and OR groups of conditions
+func (c FilterConditions) Groups() []FilterConditionGroup {
+	and := c.And
+	if and == nil {
+		and = []FilterCondition{}
+	}
+
+	or := c.Or
+	if or == nil {
+		or = []FilterCondition{}
+	}
+
+	return []FilterConditionGroup{
+		{Glue: FilterGlueAnd, Conditions: and},
+		{Glue: FilterGlueOr, Conditions: or},
+	}
+}
+
+// Encodes conditions into the nested structure expected by Pipedrive
+func (c FilterConditions) MarshalJSON() ([]byte, error) {
+	root := struct {
+		Glue       FilterGlue             `json:"glue"`
+		Conditions []FilterConditionGroup `json:"conditions"`
+	}{
+		Glue:       FilterGlueAnd,
+		Conditions: c.Groups(),
+	}
+
+	return json.Marshal(root)
+}
@@ -0,0 +1,301 @@
+package pipedrive
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Types of filters
+type FilterType string
+
+const (
+	FilterTypeDeals    FilterType = "deals"
+	FilterTypeLeads    FilterType = "leads"
+	FilterTypeOrg      FilterType = "org"
+	FilterTypePeople   FilterType = "people"
+	FilterTypeProducts FilterType = "products"
+	FilterTypeActivity FilterType = "activity"
+	FilterTypeProjects FilterType = "projects"
+)
+
+type Filter struct {
+	Id            int        `json:"id"`
+	Name          string     `json:"name"`
+	ActiveFlag    bool       `json:"active_flag"`
+	Type          FilterType `json:"type"`
+	TemporaryFlag bool       `json:"temporary_flag"`
+	UserId        int        `json:"user_id"`
+	AddTime       string     `json:"add_time"`
+	UpdateTime    string     `json:"update_time"`
+	VisibleTo     string     `json:"visible_to"`
+	CustomViewId  int        `json:"custom_view_id"`
+
+	// Raw conditions as returned by Pipedrive. Filled only by GetFilter.
+	Conditions json.RawMessage `json:"conditions"`
+}
+
+// Get all filters
+//
+// Returns data about all filters. If filterType is empty filters of all types are returned.
+//
+// https://developers.pipedrive.com/docs/api/v1/Filters#getFilters
+func (p *Pipedrive) ListFilters(filterType FilterType) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("filters")
+
+	if filterType != "" {
+		url.Query.Add("type", string(filterType))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get one filter
+//
+// Returns data about a specific filter. Note that this also returns the condition lines of the filter.
+//
+// https://developers.pipedrive.com/docs/api/v1/Filters#getFilter
+func (p *Pipedrive) GetFilter(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("filters/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get all filter helpers
+//
+// Returns all supported filter helpers. It helps to know what conditions and
+// helpers are available when you want to add or update filters.
+//
+// https://developers.pipedrive.com/docs/api/v1/Filters#getFilterHelpers
+func (p *Pipedrive) GetFilterHelpers() (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("filters/helpers")
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Add a new filter
+//
+// Adds a new filter, returns the ID upon success. Note that in the conditions
+// JSON object only one first-level condition group is supported, and it must
+// be glued with 'AND', and only two second level condition groups are supported
+// of which one must be glued with 'AND' and the second with 'OR'.
+// FilterConditions takes care of that.
+//
+// https://developers.pipedrive.com/docs/api/v1/Filters#addFilter
+func (p *Pipedrive) AddFilter(name string, filterType FilterType, conditions FilterConditions) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("filters")
+
+	if name == "" {
+		return nil, errors.New("Filter name is required")
+	}
+
+	if filterType == "" {
+		return nil, errors.New("Filter type is required")
+	}
+
+	if len(conditions.And) < 1 && len(conditions.Or) < 1 {
+		return nil, errors.New("At least one condition is required")
+	}
+
+	body := map[string]interface{}{
+		"name":       name,
+		"type":       filterType,
+		"conditions": conditions,
+	}
+
+	json_data, err := json.Marshal(body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Update filter
+//
+// Updates an existing filter. If name is empty it is left untouched.
+//
+// https://developers.pipedrive.com/docs/api/v1/Filters#updateFilter
+func (p *Pipedrive) UpdateFilter(id int, name string, conditions FilterConditions) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("filters/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	body := map[string]interface{}{
+		"conditions": conditions,
+	}
+
+	if name != "" {
+		body["name"] = name
+	}
+
+	json_data, err := json.Marshal(body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	req, err := http.NewRequest("PUT", url.String(), buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete a filter
+//
+// Marks a filter as deleted.
+//
+// https://developers.pipedrive.com/docs/api/v1/Filters#deleteFilter
+func (p *Pipedrive) DeleteFilter(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("filters/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete multiple filters in bulk
+//
+// Marks multiple filters as deleted.
+//
+// https://developers.pipedrive.com/docs/api/v1/Filters#deleteFilters
+func (p *Pipedrive) DeleteFilters(ids []int) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("filters")
+
+	if len(ids) < 1 {
+		return nil, errors.New("At least one id is required")
+	}
+
+	str_ids := make([]string, len(ids))
+	for idx, id := range ids {
+		str_ids[idx] = strconv.Itoa(id)
+	}
+	url.Query.Add("ids", strings.Join(str_ids, ","))
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Run a function with a temporary filter
+//
+// Creates a filter with the given conditions, calls fn with its id and
+// deletes the filter afterwards, even if fn fails or panics. If both fn and
+// the deletion fail, the error of fn is returned wrapped with the other one.
+//
+//	err := pd.WithTemporaryFilter(pipedrive.FilterTypeDeals, conditions, func(filterId int) error {
+//		resp, err := pd.ListDeals(pipedrive.DealsFilter{Filter: filterId})
+//		...
+//	})
+func (p *Pipedrive) WithTemporaryFilter(filterType FilterType, conditions FilterConditions, fn func(filterId int) error) (err error) {
+	name := fmt.Sprintf("Temporary filter %d", time.Now().UnixNano())
+	pd_resp, err := p.AddFilter(name, filterType, conditions)
+
+	if err != nil {
+		return err
+	}
+
+	var filter struct {
+		Id int `json:"id"`
+	}
+	err = pd_resp.DecodeData(&filter)
+
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		del_resp, del_err := p.DeleteFilter(filter.Id)
+
+		if del_err == nil && del_resp.Status >= 400 {
+			del_err = errors.New(del_resp.ErrorMsg)
+		}
+
+		if del_err == nil {
+			return
+		}
+
+		if err != nil {
+			err = fmt.Errorf("%w (temporary filter was not deleted: %v)", err, del_err)
+			return
+		}
+
+		err = del_err
+	}()
+
+	return fn(filter.Id)
+}
@@ -0,0 +1,381 @@
+package pipedrive
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Types of goals
+type GoalTypeName string
+
+const (
+	GoalDealsWon            GoalTypeName = "deals_won"
+	GoalDealsProgressed     GoalTypeName = "deals_progressed"
+	GoalActivitiesCompleted GoalTypeName = "activities_completed"
+	GoalActivitiesAdded     GoalTypeName = "activities_added"
+	GoalDealsStarted        GoalTypeName = "deals_started"
+	GoalRevenueForecast     GoalTypeName = "revenue_forecast"
+)
+
+// Who the goal is assigned to
+type GoalAssigneeType string
+
+const (
+	GoalAssigneePerson  GoalAssigneeType = "person"
+	GoalAssigneeCompany GoalAssigneeType = "company"
+	GoalAssigneeTeam    GoalAssigneeType = "team"
+)
+
+type GoalInterval string
+
+const (
+	GoalIntervalWeekly    GoalInterval = "weekly"
+	GoalIntervalMonthly   GoalInterval = "monthly"
+	GoalIntervalQuarterly GoalInterval = "quarterly"
+	GoalIntervalYearly    GoalInterval = "yearly"
+)
+
+// How the goal progress is measured
+type GoalTrackingMetric string
+
+const (
+	GoalTrackingQuantity GoalTrackingMetric = "quantity"
+	GoalTrackingSum      GoalTrackingMetric = "sum"
+)
+
+type GoalAssignee struct {
+	Id   int              `json:"id"`
+	Type GoalAssigneeType `json:"type"`
+}
+
+// Parameters of the goal type
+type GoalTypeParams struct {
+	// Pipelines of the deals goals, empty means all pipelines
+	PipelineId []int `json:"pipeline_id,omitempty"`
+
+	// Stage of the deals progressed goal
+	StageId int `json:"stage_id,omitempty"`
+
+	// Activity types of the activities goals
+	ActivityTypeId []int `json:"activity_type_id,omitempty"`
+}
+
+type GoalType struct {
+	Name   GoalTypeName   `json:"name"`
+	Params GoalTypeParams `json:"params"`
+}
+
+type GoalExpectedOutcome struct {
+	Target         float64            `json:"target"`
+	TrackingMetric GoalTrackingMetric `json:"tracking_metric"`
+
+	// Required when tracking metric is sum
+	CurrencyId int `json:"currency_id,omitempty"`
+}
+
+// Period of the goal. Format: YYYY-MM-DD
+type GoalDuration struct {
+	Start string `json:"start"`
+
+	// Empty for goals without end
+	End string `json:"end,omitempty"`
+}
+
+// Goal.
+//
+// The same model is used to add and update goals, read-only fields are
+// omitted when empty.
+type Goal struct {
+	Id              string               `json:"id,omitempty"`
+	Title           string               `json:"title,omitempty"`
+	Assignee        *GoalAssignee        `json:"assignee,omitempty"`
+	Type            *GoalType            `json:"type,omitempty"`
+	ExpectedOutcome *GoalExpectedOutcome `json:"expected_outcome,omitempty"`
+	Duration        *GoalDuration        `json:"duration,omitempty"`
+	Interval        GoalInterval         `json:"interval,omitempty"`
+
+	// Read only fields
+	OwnerId   int      `json:"owner_id,omitempty"`
+	IsActive  bool     `json:"is_active,omitempty"`
+	ReportIds []string `json:"report_ids,omitempty"`
+}
+
+// Progress of a goal
+type GoalResult struct {
+	Progress float64 `json:"progress"`
+	Goal     Goal    `json:"goal"`
+}
+
+// Goals search options. Empty values are not used.
+type GoalsFilter struct {
+	TypeName     GoalTypeName
+	Title        string
+	IsActive     *bool
+	AssigneeId   int
+	AssigneeType GoalAssigneeType
+
+	ExpectedOutcomeTarget         float64
+	ExpectedOutcomeTrackingMetric GoalTrackingMetric
+	ExpectedOutcomeCurrencyId     int
+
+	PipelineId     []int
+	StageId        int
+	ActivityTypeId []int
+
+	// Period of the goals. Format: YYYY-MM-DD.
+	// Both dates must be set to search by period.
+	PeriodStart string
+	PeriodEnd   string
+}
+
+func joinIds(ids []int) string {
+	str_ids := make([]string, len(ids))
+	for idx, id := range ids {
+		str_ids[idx] = strconv.Itoa(id)
+	}
+
+	return strings.Join(str_ids, ",")
+}
+
+// Find goals
+//
+// Returns data about goals based on criteria. For searching, append
+// {searchField}={searchValue} to the URL, where searchField can be any one
+// of the lowest-level fields in dot-notation (e.g. type.params.pipeline_id;
+// title). Without criteria all goals are returned.
+//
+// https://developers.pipedrive.com/docs/api/v1/Goals#getGoals
+func (p *Pipedrive) FindGoals(f GoalsFilter) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("goals/find")
+
+	if f.TypeName != "" {
+		url.Query.Add("type.name", string(f.TypeName))
+	}
+
+	if f.Title != "" {
+		url.Query.Add("title", f.Title)
+	}
+
+	if f.IsActive != nil {
+		url.Query.Add("is_active", strconv.FormatBool(*f.IsActive))
+	}
+
+	if f.AssigneeId > 0 {
+		url.Query.Add("assignee.id", strconv.Itoa(f.AssigneeId))
+	}
+
+	if f.AssigneeType != "" {
+		url.Query.Add("assignee.type", string(f.AssigneeType))
+	}
+
+	if f.ExpectedOutcomeTarget > 0 {
+		url.Query.Add("expected_outcome.target", strconv.FormatFloat(f.ExpectedOutcomeTarget, 'f', -1, 64))
+	}
+
+	if f.ExpectedOutcomeTrackingMetric != "" {
+		url.Query.Add("expected_outcome.tracking_metric", string(f.ExpectedOutcomeTrackingMetric))
+	}
+
+	if f.ExpectedOutcomeCurrencyId > 0 {
+		url.Query.Add("expected_outcome.currency_id", strconv.Itoa(f.ExpectedOutcomeCurrencyId))
+	}
+
+	if len(f.PipelineId) > 0 {
+		url.Query.Add("type.params.pipeline_id", joinIds(f.PipelineId))
+	}
+
+	if f.StageId > 0 {
+		url.Query.Add("type.params.stage_id", strconv.Itoa(f.StageId))
+	}
+
+	if len(f.ActivityTypeId) > 0 {
+		url.Query.Add("type.params.activity_type_id", joinIds(f.ActivityTypeId))
+	}
+
+	if f.PeriodStart != "" && f.PeriodEnd != "" {
+		url.Query.Add("period.start", f.PeriodStart)
+		url.Query.Add("period.end", f.PeriodEnd)
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Add a new goal
+//
+// Adds a new goal. Along with adding a new goal, a report is created to
+// track the progress of your goal. Assignee, Type, ExpectedOutcome, Duration
+// and Interval are required.
+//
+// https://developers.pipedrive.com/docs/api/v1/Goals#addGoal
+func (p *Pipedrive) AddGoal(goal Goal) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("goals")
+
+	if goal.Assignee == nil || goal.Type == nil {
+		return nil, errors.New("Goal assignee and type are required")
+	}
+
+	if goal.ExpectedOutcome == nil || goal.Duration == nil {
+		return nil, errors.New("Goal expected outcome and duration are required")
+	}
+
+	if goal.Interval == "" {
+		return nil, errors.New("Goal interval is required")
+	}
+
+	json_data, err := json.Marshal(goalParams(goal))
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Update existing goal
+//
+// Updates an existing goal. Empty values are left untouched.
+//
+// https://developers.pipedrive.com/docs/api/v1/Goals#updateGoal
+func (p *Pipedrive) UpdateGoal(id string, goal Goal) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("goals/%s", id)
+	url := p.makeApiEndpoint(ep)
+
+	json_data, err := json.Marshal(goalParams(goal))
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	req, err := http.NewRequest("PUT", url.String(), buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Strips read only fields
+func goalParams(goal Goal) Goal {
+	return Goal{
+		Title:           goal.Title,
+		Assignee:        goal.Assignee,
+		Type:            goal.Type,
+		ExpectedOutcome: goal.ExpectedOutcome,
+		Duration:        goal.Duration,
+		Interval:        goal.Interval,
+	}
+}
+
+// Delete existing goal
+//
+// Marks a goal as deleted.
+//
+// https://developers.pipedrive.com/docs/api/v1/Goals#deleteGoal
+func (p *Pipedrive) DeleteGoal(id string) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("goals/%s", id)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get result of a goal
+//
+// Gets the progress of a goal for the specified period.
+// Format of the dates: YYYY-MM-DD
+//
+// https://developers.pipedrive.com/docs/api/v1/Goals#getGoalResult
+func (p *Pipedrive) GetGoalResult(id string, periodStart string, periodEnd string) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("goals/%s/results", id)
+	url := p.makeApiEndpoint(ep)
+
+	if periodStart == "" || periodEnd == "" {
+		return nil, errors.New("Period start and period end are required")
+	}
+
+	url.Query.Add("period.start", periodStart)
+	url.Query.Add("period.end", periodEnd)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get progress of a goal
+//
+// Same as GetGoalResult, but returns the typed result.
+func (p *Pipedrive) GetGoalProgress(id string, periodStart string, periodEnd string) (*GoalResult, error) {
+	pd_resp, err := p.GetGoalResult(id, periodStart, periodEnd)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var result GoalResult
+	err = pd_resp.DecodeData(&result)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Returns share of the target reached, 1 means the goal is achieved
+func (r GoalResult) Ratio() float64 {
+	if r.Goal.ExpectedOutcome == nil || r.Goal.ExpectedOutcome.Target == 0 {
+		return 0
+	}
+
+	return r.Progress / r.Goal.ExpectedOutcome.Target
+}
@@ -0,0 +1,408 @@
+package pipedrive
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Searchable item types
+type ItemType string
+
+const (
+	ItemTypeDeal           ItemType = "deal"
+	ItemTypePerson         ItemType = "person"
+	ItemTypeOrganization   ItemType = "organization"
+	ItemTypeProduct        ItemType = "product"
+	ItemTypeLead           ItemType = "lead"
+	ItemTypeFile           ItemType = "file"
+	ItemTypeMailAttachment ItemType = "mail_attachment"
+	ItemTypeProject        ItemType = "project"
+)
+
+// Fields to search in. Only the fields applicable to the given item types are
+// taken into account.
+type ItemSearchField string
+
+const (
+	ItemSearchInAddress          ItemSearchField = "address"
+	ItemSearchInCode             ItemSearchField = "code"
+	ItemSearchInCustom           ItemSearchField = "custom_fields"
+	ItemSearchInEmail            ItemSearchField = "email"
+	ItemSearchInName             ItemSearchField = "name"
+	ItemSearchInNotes            ItemSearchField = "notes"
+	ItemSearchInOrganizationName ItemSearchField = "organization_name"
+	ItemSearchInPersonName       ItemSearchField = "person_name"
+	ItemSearchInPhone            ItemSearchField = "phone"
+	ItemSearchInTitle            ItemSearchField = "title"
+	ItemSearchInDescription      ItemSearchField = "description"
+)
+
+// Optional fields to include in the results
+type ItemSearchIncludeField string
+
+const (
+	ItemSearchIncludeDealCcEmail   ItemSearchIncludeField = "deal.cc_email"
+	ItemSearchIncludePersonPicture ItemSearchIncludeField = "person.picture"
+	ItemSearchIncludeProductPrice  ItemSearchIncludeField = "product.price"
+)
+
+// Search parameters
+type SearchItemsOptions struct {
+	// The search term to look for. Minimum 2 characters (or 1 if using Exact).
+	Term string
+
+	// The types of items to search for. Defaults to all of them.
+	ItemTypes []ItemType
+
+	// The fields to perform the search from. Defaults to all of them.
+	Fields []ItemSearchField
+
+	// When enabled, the response will include up to 100 newest related leads
+	// and 100 newest related deals for each found person and organization and
+	// up to 100 newest related persons for each found organization.
+	SearchForRelatedItems bool
+
+	// When enabled, only full exact matches against the given term are returned.
+	// It is not case sensitive.
+	Exact bool
+
+	// Optional fields to include in the results
+	IncludeFields []ItemSearchIncludeField
+
+	// Pagination start. Note that the pagination is based on main results and
+	// does not include related items when using SearchForRelatedItems.
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+}
+
+// Search multiple items
+//
+// Performs a search from your choice of item types and fields.
+// Decode the response with DecodeData into ItemSearchResult to get typed results.
+//
+// https://developers.pipedrive.com/docs/api/v1/ItemSearch#searchItem
+func (p *Pipedrive) SearchItems(opt SearchItemsOptions) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("itemSearch")
+	if opt.Term != "" {
+		url.Query.Add("term", opt.Term)
+	} else {
+		return nil, errors.New("Option 'Term' cannot be empty")
+	}
+
+	if len(opt.ItemTypes) >= 1 {
+		types := make([]string, len(opt.ItemTypes))
+		for idx, t := range opt.ItemTypes {
+			types[idx] = string(t)
+		}
+		url.Query.Add("item_types", strings.Join(types, ","))
+	}
+
+	if len(opt.Fields) >= 1 {
+		fields := make([]string, len(opt.Fields))
+		for idx, fld := range opt.Fields {
+			fields[idx] = string(fld)
+		}
+		url.Query.Add("fields", strings.Join(fields, ","))
+	}
+
+	if opt.SearchForRelatedItems == true {
+		url.Query.Add("search_for_related_items", "true")
+	}
+
+	if opt.Exact == true {
+		url.Query.Add("exact_match", "true")
+	}
+
+	if len(opt.IncludeFields) >= 1 {
+		fields := make([]string, len(opt.IncludeFields))
+		for idx, fld := range opt.IncludeFields {
+			fields[idx] = string(fld)
+		}
+		url.Query.Add("include_fields", strings.Join(fields, ","))
+	}
+
+	if opt.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(opt.Start))
+	}
+
+	if opt.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(opt.Limit))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Field types which can be searched by field value
+type FieldSearchType string
+
+const (
+	FieldSearchTypeDeal         FieldSearchType = "dealField"
+	FieldSearchTypeLead         FieldSearchType = "leadField"
+	FieldSearchTypePerson       FieldSearchType = "personField"
+	FieldSearchTypeOrganization FieldSearchType = "organizationField"
+	FieldSearchTypeProduct      FieldSearchType = "productField"
+	FieldSearchTypeProject      FieldSearchType = "projectField"
+)
+
+// Search by field parameters
+type SearchItemsByFieldOptions struct {
+	// The search term to look for. Minimum 2 characters (or 1 if using Exact).
+	Term string
+
+	// The type of the field to perform the search from
+	FieldType FieldSearchType
+
+	// The key of the field to search from. The field key can be obtained by
+	// fetching the list of the fields using any of the fields' API GET methods
+	// (dealFields, personFields, etc.).
+	FieldKey string
+
+	// When enabled, only full exact matches against the given term are returned.
+	// The search is case sensitive.
+	Exact bool
+
+	// Whether to return the IDs of the matching items or not. When not set or
+	// set to false, only distinct values of the searched field are returned.
+	ReturnItemIds bool
+
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+}
+
+// Search item by field
+//
+// Performs a search from the values of a specific field. Results can either be
+// the distinct values of the field (useful for searching autocomplete field
+// values), or the IDs of actual items (deals, leads, persons, organizations or products).
+//
+// https://developers.pipedrive.com/docs/api/v1/ItemSearch#searchItemByField
+func (p *Pipedrive) SearchItemsByField(opt SearchItemsByFieldOptions) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("itemSearch/field")
+	if opt.Term != "" {
+		url.Query.Add("term", opt.Term)
+	} else {
+		return nil, errors.New("Option 'Term' cannot be empty")
+	}
+
+	if opt.FieldType == "" {
+		return nil, errors.New("Option 'FieldType' cannot be empty")
+	}
+	url.Query.Add("field_type", string(opt.FieldType))
+
+	if opt.FieldKey == "" {
+		return nil, errors.New("Option 'FieldKey' cannot be empty")
+	}
+	url.Query.Add("field_key", opt.FieldKey)
+
+	if opt.Exact == true {
+		url.Query.Add("exact_match", "true")
+	}
+
+	if opt.ReturnItemIds == true {
+		url.Query.Add("return_item_ids", "true")
+	}
+
+	if opt.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(opt.Start))
+	}
+
+	if opt.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(opt.Limit))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Result of the item search
+type ItemSearchResult struct {
+	Items []ItemSearchHit `json:"items"`
+
+	// Filled only when SearchForRelatedItems is enabled
+	RelatedItems []ItemSearchHit `json:"related_items"`
+}
+
+// Single found item along with its match score
+type ItemSearchHit struct {
+	ResultScore float64        `json:"result_score"`
+	Item        ItemSearchItem `json:"item"`
+}
+
+// Found item.
+//
+// Exactly one of the typed fields is set according to the Type of the item.
+// Raw keeps the original item data for types unknown to this library.
+type ItemSearchItem struct {
+	Type ItemType
+
+	Deal         *DealSearchItem
+	Person       *PersonSearchItem
+	Organization *OrgSearchItem
+	Product      *ProductSearchItem
+	Lead         *LeadSearchItem
+	File         *FileSearchItem
+	Project      *ProjectSearchItem
+
+	Raw json.RawMessage
+}
+
+func (i *ItemSearchItem) UnmarshalJSON(data []byte) error {
+	var head struct {
+		Type ItemType `json:"type"`
+	}
+
+	err := json.Unmarshal(data, &head)
+
+	if err != nil {
+		return err
+	}
+
+	i.Type = head.Type
+	i.Raw = append(json.RawMessage{}, data...)
+
+	switch head.Type {
+	case ItemTypeDeal:
+		i.Deal = &DealSearchItem{}
+		return json.Unmarshal(data, i.Deal)
+	case ItemTypePerson:
+		i.Person = &PersonSearchItem{}
+		return json.Unmarshal(data, i.Person)
+	case ItemTypeOrganization:
+		i.Organization = &OrgSearchItem{}
+		return json.Unmarshal(data, i.Organization)
+	case ItemTypeProduct:
+		i.Product = &ProductSearchItem{}
+		return json.Unmarshal(data, i.Product)
+	case ItemTypeLead:
+		i.Lead = &LeadSearchItem{}
+		return json.Unmarshal(data, i.Lead)
+	case ItemTypeFile, ItemTypeMailAttachment:
+		i.File = &FileSearchItem{}
+		return json.Unmarshal(data, i.File)
+	case ItemTypeProject:
+		i.Project = &ProjectSearchItem{}
+		return json.Unmarshal(data, i.Project)
+	}
+
+	return nil
+}
+
+// Reference to a related entity inside of a found item
+type SearchItemRef struct {
+	Id      int    `json:"id"`
+	Name    string `json:"name,omitempty"`
+	Title   string `json:"title,omitempty"`
+	Address string `json:"address,omitempty"`
+}
+
+type DealSearchItem struct {
+	Id           int            `json:"id"`
+	Title        string         `json:"title"`
+	Value        float64        `json:"value"`
+	Currency     string         `json:"currency"`
+	Status       string         `json:"status"`
+	VisibleTo    int            `json:"visible_to"`
+	Owner        *SearchItemRef `json:"owner"`
+	Stage        *SearchItemRef `json:"stage"`
+	Person       *SearchItemRef `json:"person"`
+	Organization *SearchItemRef `json:"organization"`
+	CustomFields []string       `json:"custom_fields"`
+	Notes        []string       `json:"notes"`
+	CcEmail      string         `json:"cc_email"`
+}
+
+type PersonSearchItem struct {
+	Id           int            `json:"id"`
+	Name         string         `json:"name"`
+	Phones       []string       `json:"phones"`
+	Emails       []string       `json:"emails"`
+	VisibleTo    int            `json:"visible_to"`
+	Owner        *SearchItemRef `json:"owner"`
+	Organization *SearchItemRef `json:"organization"`
+	CustomFields []string       `json:"custom_fields"`
+	Notes        []string       `json:"notes"`
+}
+
+type OrgSearchItem struct {
+	Id           int            `json:"id"`
+	Name         string         `json:"name"`
+	Address      string         `json:"address"`
+	VisibleTo    int            `json:"visible_to"`
+	Owner        *SearchItemRef `json:"owner"`
+	CustomFields []string       `json:"custom_fields"`
+	Notes        []string       `json:"notes"`
+}
+
+type ProductSearchItem struct {
+	Id           int            `json:"id"`
+	Name         string         `json:"name"`
+	Code         string         `json:"code"`
+	VisibleTo    int            `json:"visible_to"`
+	Owner        *SearchItemRef `json:"owner"`
+	CustomFields []string       `json:"custom_fields"`
+}
+
+type LeadSearchItem struct {
+	Id           string         `json:"id"`
+	Title        string         `json:"title"`
+	Value        float64        `json:"value"`
+	Currency     string         `json:"currency"`
+	IsArchived   bool           `json:"is_archived"`
+	VisibleTo    int            `json:"visible_to"`
+	Owner        *SearchItemRef `json:"owner"`
+	Person       *SearchItemRef `json:"person"`
+	Organization *SearchItemRef `json:"organization"`
+	Phones       []string       `json:"phones"`
+	Emails       []string       `json:"emails"`
+	CustomFields []string       `json:"custom_fields"`
+	Notes        []string       `json:"notes"`
+}
+
+// File or mail attachment
+type FileSearchItem struct {
+	Id           int            `json:"id"`
+	Name         string         `json:"name"`
+	Url          string         `json:"url"`
+	VisibleTo    int            `json:"visible_to"`
+	Deal         *SearchItemRef `json:"deal"`
+	Person       *SearchItemRef `json:"person"`
+	Organization *SearchItemRef `json:"organization"`
+	Product      *SearchItemRef `json:"product"`
+}
+
+type ProjectSearchItem struct {
+	Id           int            `json:"id"`
+	Title        string         `json:"title"`
+	Description  string         `json:"description"`
+	Status       string         `json:"status"`
+	Owner        *SearchItemRef `json:"owner"`
+	Person       *SearchItemRef `json:"person"`
+	Organization *SearchItemRef `json:"organization"`
+	CustomFields []string       `json:"custom_fields"`
+	Notes        []string       `json:"notes"`
+}
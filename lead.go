@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 )
 
 type LeadsArchivedStatus int
@@ -132,3 +133,266 @@ func (p *Pipedrive) UpdateLead(id string, body map[string]interface{}) (*Pipedri
 	pd_resp := p.readResponse(resp)
 	return pd_resp, nil
 }
+
+type LeadValue struct {
+	Amount   float64 `json:"amount"`
+	Currency string  `json:"currency"`
+}
+
+type Lead struct {
+	Id                string     `json:"id"`
+	Title             string     `json:"title"`
+	OwnerId           int        `json:"owner_id"`
+	CreatorId         int        `json:"creator_id"`
+	LabelIds          []string   `json:"label_ids"`
+	PersonId          int        `json:"person_id"`
+	OrganizationId    int        `json:"organization_id"`
+	SourceName        string     `json:"source_name"`
+	IsArchived        bool       `json:"is_archived"`
+	WasSeen           bool       `json:"was_seen"`
+	Value             *LeadValue `json:"value"`
+	ExpectedCloseDate string     `json:"expected_close_date"`
+	NextActivityId    int        `json:"next_activity_id"`
+	AddTime           string     `json:"add_time"`
+	UpdateTime        string     `json:"update_time"`
+	VisibleTo         string     `json:"visible_to"`
+	CcEmail           string     `json:"cc_email"`
+}
+
+// Get one lead
+//
+// Returns details of a specific lead. If a lead contains custom fields,
+// the fields' values will be included in the response in the same format
+// as with the Deals endpoints.
+//
+// https://developers.pipedrive.com/docs/api/v1/Leads#getLead
+func (p *Pipedrive) GetLead(id string) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("leads/%s", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete a lead
+//
+// https://developers.pipedrive.com/docs/api/v1/Leads#deleteLead
+func (p *Pipedrive) DeleteLead(id string) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("leads/%s", id)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Lead search fields enum
+type LeadSearchField int
+
+const (
+	LeadSearchInCustom LeadSearchField = iota
+	LeadSearchInNotes
+	LeadSearchInTitle
+)
+
+// Returns enum value
+func (sf LeadSearchField) String() string {
+	return [...]string{"custom_fields", "notes", "title"}[sf]
+}
+
+// Search parameters
+type SearchLeadsOptions struct {
+	// The search term to look for. Minimum 2 characters (or 1 if using Exact).
+	Term string
+
+	// The fields to perform the search from. Defaults to all of them.
+	Fields []LeadSearchField
+
+	// When enabled, only full exact matches against the given term are returned.
+	// It is not case sensitive.
+	Exact bool
+
+	// Will filter leads by the provided person ID
+	Person int
+
+	// Will filter leads by the provided organization ID
+	Organization int
+
+	// When enabled, the response will include the lead.was_seen field
+	IncludeWasSeen bool
+
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+}
+
+// Search leads
+//
+// Searches all leads by title, notes and/or custom fields.
+// This endpoint is a wrapper of /v1/itemSearch with a narrower OAuth scope.
+//
+// https://developers.pipedrive.com/docs/api/v1/Leads#searchLeads
+func (p *Pipedrive) SearchLeads(opt SearchLeadsOptions) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("leads/search")
+	if opt.Term != "" {
+		url.Query.Add("term", opt.Term)
+	} else {
+		return nil, errors.New("Option 'Term' cannot be empty")
+	}
+
+	if len(opt.Fields) >= 1 {
+		fields := make([]string, len(opt.Fields))
+		for idx, fld := range opt.Fields {
+			fields[idx] = fld.String()
+		}
+		url.Query.Add("fields", strings.Join(fields, ","))
+	}
+
+	if opt.Exact == true {
+		url.Query.Add("exact_match", "true")
+	}
+
+	if opt.Person > 0 {
+		url.Query.Add("person_id", strconv.Itoa(opt.Person))
+	}
+
+	if opt.Organization > 0 {
+		url.Query.Add("organization_id", strconv.Itoa(opt.Organization))
+	}
+
+	if opt.IncludeWasSeen == true {
+		url.Query.Add("include_fields", "lead.was_seen")
+	}
+
+	if opt.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(opt.Start))
+	}
+
+	if opt.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(opt.Limit))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Convert a lead to a deal
+//
+// Archives the lead, then creates a deal from its title, value, person,
+// organization and owner. Fields from extra are added to the deal as is and
+// take precedence over the values taken from the lead, so it can be used to
+// pass stage_id, pipeline_id or custom fields.
+//
+// Archived leads are refused, so converting the same lead again never creates
+// a second deal. If Pipedrive rejects the deal the lead is restored and can be
+// converted again. If the deal request fails without a response the lead is
+// left archived, as the deal may have been created.
+//
+// Returns the response of the deal creation.
+func (p *Pipedrive) ConvertLeadToDeal(id string, extra map[string]interface{}) (*PipedriveResponse, error) {
+	pd_resp, err := p.GetLead(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var lead Lead
+	err = pd_resp.DecodeData(&lead)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if lead.IsArchived {
+		return nil, errors.New("Lead is archived, it may be converted already")
+	}
+
+	deal := map[string]interface{}{"title": lead.Title}
+
+	if lead.Value != nil {
+		deal["value"] = lead.Value.Amount
+		deal["currency"] = lead.Value.Currency
+	}
+
+	if lead.PersonId > 0 {
+		deal["person_id"] = lead.PersonId
+	}
+
+	if lead.OrganizationId > 0 {
+		deal["org_id"] = lead.OrganizationId
+	}
+
+	if lead.OwnerId > 0 {
+		deal["user_id"] = lead.OwnerId
+	}
+
+	if lead.ExpectedCloseDate != "" {
+		deal["expected_close_date"] = lead.ExpectedCloseDate
+	}
+
+	for k, v := range extra {
+		deal[k] = v
+	}
+
+	pd_resp, err = p.UpdateLead(id, map[string]interface{}{"is_archived": true})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if pd_resp.Status >= 400 {
+		msg := fmt.Sprintf("Lead was not archived: %s", pd_resp.ErrorMsg)
+		return nil, errors.New(msg)
+	}
+
+	deal_resp, err := p.AddDeal(deal)
+
+	if err != nil {
+		return nil, fmt.Errorf("%w (lead is left archived)", err)
+	}
+
+	if deal_resp.Status >= 400 || deal_resp.Success == false {
+		pd_resp, err = p.UpdateLead(id, map[string]interface{}{"is_archived": false})
+
+		if err == nil && pd_resp.Status >= 400 {
+			err = errors.New(pd_resp.ErrorMsg)
+		}
+
+		if err != nil {
+			msg := fmt.Sprintf("%s (lead was not restored: %v)", deal_resp.ErrorMsg, err)
+			return deal_resp, errors.New(msg)
+		}
+
+		return deal_resp, errors.New(deal_resp.ErrorMsg)
+	}
+
+	return deal_resp, nil
+}
@@ -1,9 +1,33 @@
 package pipedrive
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 )
 
+type LeadLabelColor string
+
+const (
+	LeadLabelColorGreen  LeadLabelColor = "green"
+	LeadLabelColorBlue   LeadLabelColor = "blue"
+	LeadLabelColorRed    LeadLabelColor = "red"
+	LeadLabelColorYellow LeadLabelColor = "yellow"
+	LeadLabelColorPurple LeadLabelColor = "purple"
+	LeadLabelColorGray   LeadLabelColor = "gray"
+)
+
+type LeadLabel struct {
+	Id         string         `json:"id,omitempty"`
+	Name       string         `json:"name,omitempty"`
+	Color      LeadLabelColor `json:"color,omitempty"`
+	AddTime    string         `json:"add_time,omitempty"`
+	UpdateTime string         `json:"update_time,omitempty"`
+}
+
 func (p *Pipedrive) GetLeadLabels() (*PipedriveResponse, error) {
 	url := p.makeApiEndpoint("leadLabels")
 
@@ -16,3 +40,139 @@ func (p *Pipedrive) GetLeadLabels() (*PipedriveResponse, error) {
 	pd_resp := p.readResponse(resp)
 	return pd_resp, nil
 }
+
+// Add a lead label
+//
+// https://developers.pipedrive.com/docs/api/v1/LeadLabels#addLeadLabel
+func (p *Pipedrive) AddLeadLabel(label LeadLabel) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("leadLabels")
+
+	if label.Name == "" {
+		return nil, errors.New("Label name is required")
+	}
+
+	if label.Color == "" {
+		return nil, errors.New("Label color is required")
+	}
+
+	json_data, err := json.Marshal(LeadLabel{Name: label.Name, Color: label.Color})
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Update a lead label
+//
+// Updates one or more properties of a lead label. Only properties included
+// in the request will be updated.
+//
+// https://developers.pipedrive.com/docs/api/v1/LeadLabels#updateLeadLabel
+func (p *Pipedrive) UpdateLeadLabel(id string, label LeadLabel) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("leadLabels/%s", id)
+	url := p.makeApiEndpoint(ep)
+
+	json_data, err := json.Marshal(LeadLabel{Name: label.Name, Color: label.Color})
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	req, err := http.NewRequest("PATCH", url.String(), buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete a lead label
+//
+// https://developers.pipedrive.com/docs/api/v1/LeadLabels#deleteLeadLabel
+func (p *Pipedrive) DeleteLeadLabel(id string) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("leadLabels/%s", id)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Ensure a lead label exists
+//
+// Returns the id of the label with the given name. If there is no such label
+// it is created with the given color. The color of an existing label is
+// left untouched.
+func (p *Pipedrive) EnsureLeadLabel(name string, color LeadLabelColor) (string, error) {
+	pd_resp, err := p.GetLeadLabels()
+
+	if err != nil {
+		return "", err
+	}
+
+	var labels []LeadLabel
+
+	// Account without any labels yet returns no data
+	if pd_resp.Status >= 400 || pd_resp.Data != nil {
+		err = pd_resp.DecodeData(&labels)
+
+		if err != nil {
+			return "", err
+		}
+	}
+
+	for _, label := range labels {
+		if label.Name == name {
+			return label.Id, nil
+		}
+	}
+
+	pd_resp, err = p.AddLeadLabel(LeadLabel{Name: name, Color: color})
+
+	if err != nil {
+		return "", err
+	}
+
+	var label LeadLabel
+	err = pd_resp.DecodeData(&label)
+
+	if err != nil {
+		return "", err
+	}
+
+	return label.Id, nil
+}
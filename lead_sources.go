@@ -0,0 +1,24 @@
+package pipedrive
+
+import (
+	"net/http"
+)
+
+// Get all lead sources
+//
+// Returns all lead sources. Please note that the list of lead sources is
+// fixed, it cannot be modified.
+//
+// https://developers.pipedrive.com/docs/api/v1/LeadSources#getLeadSources
+func (p *Pipedrive) GetLeadSources() (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("leadSources")
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
@@ -0,0 +1,295 @@
+package pipedrive
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	NetUrl "net/url"
+	"strconv"
+	"strings"
+)
+
+// Mailbox folders
+type MailFolder string
+
+const (
+	MailFolderInbox   MailFolder = "inbox"
+	MailFolderDrafts  MailFolder = "drafts"
+	MailFolderSent    MailFolder = "sent"
+	MailFolderArchive MailFolder = "archive"
+)
+
+// Sender or recipient of a mail
+type MailParty struct {
+	Id                   int    `json:"id"`
+	Name                 string `json:"name"`
+	EmailAddress         string `json:"email_address"`
+	MessageTime          int64  `json:"message_time"`
+	LatestSent           bool   `json:"latest_sent"`
+	LinkedPersonId       int    `json:"linked_person_id"`
+	LinkedPersonName     string `json:"linked_person_name"`
+	LinkedOrganizationId int    `json:"linked_organization_id"`
+	MailMessagePartyId   int    `json:"mail_message_party_id"`
+}
+
+type MailThreadParties struct {
+	To   []MailParty `json:"to"`
+	From []MailParty `json:"from"`
+}
+
+type MailThread struct {
+	Id        int    `json:"id"`
+	AccountId string `json:"account_id"`
+	UserId    int    `json:"user_id"`
+	Subject   string `json:"subject"`
+	Snippet   string `json:"snippet"`
+
+	SnippetDraft string `json:"snippet_draft"`
+	SnippetSent  string `json:"snippet_sent"`
+
+	Parties       MailThreadParties `json:"parties"`
+	DraftsParties MailThreadParties `json:"drafts_parties"`
+	Folders       []MailFolder      `json:"folders"`
+	MessageCount  int               `json:"message_count"`
+
+	ReadFlag                 Flag `json:"read_flag"`
+	SharedFlag               Flag `json:"shared_flag"`
+	ArchivedFlag             Flag `json:"archived_flag"`
+	DeletedFlag              Flag `json:"deleted_flag"`
+	ExternalDeletedFlag      Flag `json:"external_deleted_flag"`
+	SyncedFlag               Flag `json:"synced_flag"`
+	SmartBccFlag             Flag `json:"smart_bcc_flag"`
+	HasAttachmentsFlag       Flag `json:"has_attachments_flag"`
+	HasInlineAttachmentsFlag Flag `json:"has_inline_attachments_flag"`
+	HasRealAttachmentsFlag   Flag `json:"has_real_attachments_flag"`
+	HasDraftFlag             Flag `json:"has_draft_flag"`
+	HasSentFlag              Flag `json:"has_sent_flag"`
+	AllMessagesSentFlag      Flag `json:"all_messages_sent_flag"`
+	FirstMessageToMeFlag     Flag `json:"first_message_to_me_flag"`
+
+	MailTrackingStatus          string `json:"mail_tracking_status"`
+	MailLinkTrackingEnabledFlag Flag   `json:"mail_link_tracking_enabled_flag"`
+
+	DealId     int    `json:"deal_id"`
+	DealStatus string `json:"deal_status"`
+	LeadId     string `json:"lead_id"`
+
+	FirstMessageTimestamp        string `json:"first_message_timestamp"`
+	LastMessageTimestamp         string `json:"last_message_timestamp"`
+	LastMessageSentTimestamp     string `json:"last_message_sent_timestamp"`
+	LastMessageReceivedTimestamp string `json:"last_message_received_timestamp"`
+	AddTime                      string `json:"add_time"`
+	UpdateTime                   string `json:"update_time"`
+}
+
+type MailMessage struct {
+	Id                    int         `json:"id"`
+	AccountId             string      `json:"account_id"`
+	UserId                int         `json:"user_id"`
+	MailThreadId          int         `json:"mail_thread_id"`
+	Subject               string      `json:"subject"`
+	Snippet               string      `json:"snippet"`
+	From                  []MailParty `json:"from"`
+	To                    []MailParty `json:"to"`
+	Cc                    []MailParty `json:"cc"`
+	Bcc                   []MailParty `json:"bcc"`
+	MessageTime           string      `json:"message_time"`
+	AddTime               string      `json:"add_time"`
+	UpdateTime            string      `json:"update_time"`
+	MailTrackingStatus    string      `json:"mail_tracking_status"`
+	DraftFlag             Flag        `json:"draft_flag"`
+	SyncedFlag            Flag        `json:"synced_flag"`
+	DeletedFlag           Flag        `json:"deleted_flag"`
+	ReadFlag              Flag        `json:"read_flag"`
+	SentFlag              Flag        `json:"sent_flag"`
+	HasBodyFlag           Flag        `json:"has_body_flag"`
+	SmartBccFlag          Flag        `json:"smart_bcc_flag"`
+	SentFromPipedriveFlag Flag        `json:"sent_from_pipedrive_flag"`
+	HasAttachmentsFlag    Flag        `json:"has_attachments_flag"`
+
+	// HTML body, filled only when requested
+	Body string `json:"body"`
+
+	// Link to download the body
+	BodyUrl string `json:"body_url"`
+}
+
+// Pagination options
+type MailThreadsFilter struct {
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+}
+
+// Get mail threads
+//
+// Returns mail threads in a specified folder ordered by the most recent message within.
+//
+// https://developers.pipedrive.com/docs/api/v1/Mailbox#getMailThreads
+func (p *Pipedrive) ListMailThreads(folder MailFolder, f MailThreadsFilter) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("mailbox/mailThreads")
+
+	if folder == "" {
+		return nil, errors.New("Folder is required")
+	}
+
+	url.Query.Add("folder", string(folder))
+
+	if f.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(f.Start))
+	}
+
+	if f.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(f.Limit))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get one mail thread
+//
+// https://developers.pipedrive.com/docs/api/v1/Mailbox#getMailThread
+func (p *Pipedrive) GetMailThread(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("mailbox/mailThreads/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Mail thread details to update. Nil values are left untouched.
+type MailThreadParams struct {
+	// The ID of the deal this thread is associated with
+	DealId *int
+
+	// The ID of the lead this thread is associated with
+	LeadId *string
+
+	Shared   *bool
+	Read     *bool
+	Archived *bool
+}
+
+func flagValue(val bool) string {
+	if val {
+		return "1"
+	}
+
+	return "0"
+}
+
+// Update mail thread details
+//
+// Updates the properties of a mail thread.
+//
+// https://developers.pipedrive.com/docs/api/v1/Mailbox#updateMailThreadDetails
+func (p *Pipedrive) UpdateMailThread(id int, params MailThreadParams) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("mailbox/mailThreads/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	form := NetUrl.Values{}
+
+	if params.DealId != nil {
+		form.Add("deal_id", strconv.Itoa(*params.DealId))
+	}
+
+	if params.LeadId != nil {
+		form.Add("lead_id", *params.LeadId)
+	}
+
+	if params.Shared != nil {
+		form.Add("shared_flag", flagValue(*params.Shared))
+	}
+
+	if params.Read != nil {
+		form.Add("read_flag", flagValue(*params.Read))
+	}
+
+	if params.Archived != nil {
+		form.Add("archived_flag", flagValue(*params.Archived))
+	}
+
+	return p.sendForm("PUT", url, form)
+}
+
+// Delete mail thread
+//
+// Marks a mail thread as deleted.
+//
+// https://developers.pipedrive.com/docs/api/v1/Mailbox#deleteMailThread
+func (p *Pipedrive) DeleteMailThread(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("mailbox/mailThreads/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get all mail messages of mail thread
+//
+// https://developers.pipedrive.com/docs/api/v1/Mailbox#getMailThreadMessages
+func (p *Pipedrive) ListMailThreadMessages(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("mailbox/mailThreads/%d/mailMessages", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get one mail message
+//
+// Returns data about a specific mail message. If includeBody is true the
+// HTML body of the message is included.
+//
+// https://developers.pipedrive.com/docs/api/v1/Mailbox#getMailMessage
+func (p *Pipedrive) GetMailMessage(id int, includeBody bool) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("mailbox/mailMessages/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	url.Query.Add("include_body", flagValue(includeBody))
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
@@ -1,19 +1,27 @@
 package pipedrive
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	NetUrl "net/url"
+	"regexp"
 	"strconv"
 	"strings"
 )
 
+// Version segment of the API path, e.g. "v1"
+var apiVersionSegment = regexp.MustCompile(`^v[0-9]+$`)
+
 type Pipedrive struct {
 	BasePath   string
 	ApiKey     string
 	ApiVersion int
+
+	// Cached add-ons of the company, see HasAddon
+	addons *addonCache
 }
 
 func (p *Pipedrive) GetBasePath() string {
@@ -28,8 +36,37 @@ func (p *Pipedrive) GetBasePath() string {
 	return fmt.Sprintf("https://api.pipedrive.com/v%d", ver)
 }
 
+// Returns base path of the API v2.
+//
+// Some of the endpoints exist only in v2 of the API. When BasePath is set
+// the v2 path is derived from it: the version at the end of the path is
+// replaced, e.g. "https://company.pipedrive.com/api/v1" and
+// "https://company.pipedrive.com/v1" become "https://company.pipedrive.com/api/v2".
+// BasePath without a version is used as is.
+func (p *Pipedrive) GetV2BasePath() string {
+	if p.BasePath == "" {
+		return "https://api.pipedrive.com/api/v2"
+	}
+
+	base := strings.TrimSuffix(p.BasePath, "/")
+	idx := strings.LastIndex(base, "/")
+	if idx < 0 || !apiVersionSegment.MatchString(base[idx+1:]) {
+		return p.BasePath
+	}
+
+	base = strings.TrimSuffix(base[:idx], "/api")
+	return base + "/api/v2"
+}
+
 func (p *Pipedrive) makeApiEndpoint(endpoint string) *PdEndpoint {
-	base := p.GetBasePath()
+	return p.makeEndpoint(p.GetBasePath(), endpoint)
+}
+
+func (p *Pipedrive) makeApiV2Endpoint(endpoint string) *PdEndpoint {
+	return p.makeEndpoint(p.GetV2BasePath(), endpoint)
+}
+
+func (p *Pipedrive) makeEndpoint(base string, endpoint string) *PdEndpoint {
 	if !strings.HasSuffix(base, "/") {
 		base += "/"
 	}
@@ -69,3 +106,31 @@ func (p *Pipedrive) readResponse(resp *http.Response) *PipedriveResponse {
 
 	return &pd_resp
 }
+
+// Sends body encoded as json
+func (p *Pipedrive) sendJson(method string, url *PdEndpoint, body interface{}) (*PipedriveResponse, error) {
+	json_data, err := json.Marshal(body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	req, err := http.NewRequest(method, url.String(), buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
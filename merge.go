@@ -0,0 +1,395 @@
+package pipedrive
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Field value which differs between the merged records
+type MergeField struct {
+	Field string
+
+	// Value of the surviving record after the merge
+	Kept interface{}
+
+	// Value which is lost with the merge, nil if nothing is lost
+	Overwritten interface{}
+
+	// True if the kept value comes from the merged record, because the
+	// field is empty in the surviving one
+	FromMerged bool
+
+	// True if the kept value is a contact list (emails or phones of a person)
+	// combined from both records. Nothing is lost for such fields.
+	Combined bool
+}
+
+// Preview of a merge of two records
+//
+// Nothing is changed in Pipedrive until Confirm is called.
+type MergePreview struct {
+	// Record which survives the merge
+	SurvivingId int
+
+	// Record which is merged into the surviving one and removed
+	MergedId int
+
+	// Fields with different values, ordered by the field name
+	Fields []MergeField
+
+	merge func() (*PipedriveResponse, error)
+	done  bool
+}
+
+// Returns the fields whose values are lost with the merge
+func (m *MergePreview) Conflicts() []MergeField {
+	conflicts := []MergeField{}
+	for _, field := range m.Fields {
+		if field.Overwritten != nil {
+			conflicts = append(conflicts, field)
+		}
+	}
+
+	return conflicts
+}
+
+// Performs the merge
+//
+// Returns the id of the surviving record. The merge request is sent once,
+// subsequent calls return an error, even if the response of the first one
+// could not be read.
+func (m *MergePreview) Confirm() (int, error) {
+	if m.done {
+		return 0, errors.New("Merge is already performed")
+	}
+
+	pd_resp, err := m.merge()
+
+	if err != nil {
+		return 0, err
+	}
+
+	// The request reached Pipedrive, it must not be sent again even if
+	// the response can not be decoded
+	m.done = true
+
+	var merged struct {
+		Id int `json:"id"`
+	}
+	err = pd_resp.DecodeData(&merged)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if merged.Id > 0 {
+		return merged.Id, nil
+	}
+
+	return m.SurvivingId, nil
+}
+
+// Standard fields which can be edited by the user. Other standard fields
+// are filled by Pipedrive (owner_name, next_activity_date, ...) and are
+// not compared. Custom fields are always compared.
+var mergeEditableFields = map[string]map[string]bool{
+	"organizations": {
+		"name":       true,
+		"owner_id":   true,
+		"address":    true,
+		"visible_to": true,
+		"label":      true,
+		"label_ids":  true,
+	},
+	"persons": {
+		"name":           true,
+		"owner_id":       true,
+		"org_id":         true,
+		"email":          true,
+		"phone":          true,
+		"job_title":      true,
+		"birthday":       true,
+		"postal_address": true,
+		"visible_to":     true,
+		"label":          true,
+		"label_ids":      true,
+	},
+	"deals": {
+		"title":               true,
+		"value":               true,
+		"currency":            true,
+		"user_id":             true,
+		"person_id":           true,
+		"org_id":              true,
+		"pipeline_id":         true,
+		"stage_id":            true,
+		"status":              true,
+		"probability":         true,
+		"expected_close_date": true,
+		"lost_reason":         true,
+		"visible_to":          true,
+		"label":               true,
+	},
+}
+
+// Contact lists, Pipedrive combines them instead of overwriting
+var mergeContactFields = map[string]bool{
+	"email": true,
+	"phone": true,
+}
+
+// Keys of custom fields are 40 characters long hashes
+var customFieldKey = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+func isMergeEditableField(object string, key string) bool {
+	return mergeEditableFields[object][key] || customFieldKey.MatchString(key)
+}
+
+// Returns values of a contact list, empty values are skipped
+func mergeContactValues(v interface{}) []string {
+	items, _ := v.([]interface{})
+
+	values := []string{}
+	for _, item := range items {
+		obj, _ := item.(map[string]interface{})
+		value, _ := obj["value"].(string)
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+// Returns the value used for the comparison. Related records are compared
+// by their ids only, as their details may differ between the responses.
+func mergeCompareValue(v interface{}) interface{} {
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+
+	if id, ok := obj["value"]; ok {
+		return id
+	}
+
+	if id, ok := obj["id"]; ok {
+		return id
+	}
+
+	return v
+}
+
+func isEmptyMergeValue(v interface{}) bool {
+	switch val := v.(type) {
+	case nil:
+		return true
+	case string:
+		return val == ""
+	case []interface{}:
+		return len(val) == 0
+	case map[string]interface{}:
+		return len(val) == 0
+	}
+
+	return false
+}
+
+// Combines contact lists of both records. Items of the merged record are
+// added unless the surviving one has the same value.
+func mergeContactField(key string, sv interface{}, mv interface{}) (MergeField, bool) {
+	merged := mergeContactValues(mv)
+	if len(merged) == 0 {
+		return MergeField{}, false
+	}
+
+	if len(mergeContactValues(sv)) == 0 {
+		return MergeField{Field: key, Kept: mv, FromMerged: true}, true
+	}
+
+	known := map[string]bool{}
+	for _, value := range mergeContactValues(sv) {
+		known[strings.ToLower(value)] = true
+	}
+
+	combined, _ := sv.([]interface{})
+	combined = append([]interface{}{}, combined...)
+	added := false
+
+	items, _ := mv.([]interface{})
+	for _, item := range items {
+		obj, _ := item.(map[string]interface{})
+		value, _ := obj["value"].(string)
+		if value == "" || known[strings.ToLower(value)] {
+			continue
+		}
+
+		// Only one item of the list is primary, it stays the survivor's one
+		copied := map[string]interface{}{}
+		for k, v := range obj {
+			copied[k] = v
+		}
+		copied["primary"] = false
+
+		combined = append(combined, copied)
+		known[strings.ToLower(value)] = true
+		added = true
+	}
+
+	if !added {
+		return MergeField{}, false
+	}
+
+	return MergeField{Field: key, Kept: combined, Combined: true}, true
+}
+
+// Builds the merge preview.
+//
+// Follows Pipedrive merge rules: non-empty values of the surviving record
+// are kept, its empty fields are filled from the merged record and contact
+// lists are combined. Only the fields editable by the user are compared.
+func newMergePreview(object string, survivor map[string]interface{}, merged map[string]interface{}) []MergeField {
+	keys := map[string]bool{}
+	for k := range survivor {
+		keys[k] = true
+	}
+	for k := range merged {
+		keys[k] = true
+	}
+
+	fields := []MergeField{}
+	for k := range keys {
+		if !isMergeEditableField(object, k) {
+			continue
+		}
+
+		sv, mv := survivor[k], merged[k]
+
+		if mergeContactFields[k] {
+			field, ok := mergeContactField(k, sv, mv)
+			if ok {
+				fields = append(fields, field)
+			}
+			continue
+		}
+
+		if reflect.DeepEqual(mergeCompareValue(sv), mergeCompareValue(mv)) {
+			continue
+		}
+
+		if isEmptyMergeValue(sv) {
+			if isEmptyMergeValue(mv) {
+				continue
+			}
+
+			fields = append(fields, MergeField{Field: k, Kept: mv, FromMerged: true})
+			continue
+		}
+
+		field := MergeField{Field: k, Kept: sv}
+		if !isEmptyMergeValue(mv) {
+			field.Overwritten = mv
+		}
+		fields = append(fields, field)
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Field < fields[j].Field
+	})
+
+	return fields
+}
+
+// Fetches both records and builds the preview
+func (p *Pipedrive) previewMerge(object string, id int, mergeWithId int, get func(id int) (*PipedriveResponse, error)) (*MergePreview, error) {
+	if id <= 0 || mergeWithId <= 0 {
+		return nil, errors.New("Both ids are required")
+	}
+
+	if id == mergeWithId {
+		return nil, errors.New("Unable to merge a record with itself")
+	}
+
+	pd_resp, err := get(mergeWithId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	survivor, err := pd_resp.GetDataAsMap()
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp, err = get(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	merged, err := pd_resp.GetDataAsMap()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &MergePreview{
+		SurvivingId: mergeWithId,
+		MergedId:    id,
+		Fields:      newMergePreview(object, survivor, merged),
+		merge: func() (*PipedriveResponse, error) {
+			return p.merge(object, id, mergeWithId)
+		},
+	}, nil
+}
+
+// Merges the record with id into the record with mergeWithId
+func (p *Pipedrive) merge(object string, id int, mergeWithId int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("%s/%d/merge", object, id)
+	url := p.makeApiEndpoint(ep)
+
+	return p.sendJson("PUT", url, map[string]interface{}{"merge_with_id": mergeWithId})
+}
+
+// Merge two organizations
+//
+// Merges an organization with another organization. The organization with
+// mergeWithId survives, the other one is merged into it. Merging is
+// irreversible, so both organizations are fetched first and the preview of
+// the result is returned. The merge is performed by MergePreview.Confirm.
+//
+//	preview, err := pd.MergeOrganizations(dupId, orgId)
+//	...
+//	if len(preview.Conflicts()) == 0 {
+//		survivingId, err := preview.Confirm()
+//	}
+//
+// https://developers.pipedrive.com/docs/api/v1/Organizations#mergeOrganizations
+func (p *Pipedrive) MergeOrganizations(id int, mergeWithId int) (*MergePreview, error) {
+	return p.previewMerge("organizations", id, mergeWithId, p.GetOrganization)
+}
+
+// Merge two persons
+//
+// Merges a person with another person. The person with mergeWithId survives.
+// See MergeOrganizations.
+//
+// https://developers.pipedrive.com/docs/api/v1/Persons#mergePersons
+func (p *Pipedrive) MergePersons(id int, mergeWithId int) (*MergePreview, error) {
+	return p.previewMerge("persons", id, mergeWithId, p.GetPerson)
+}
+
+// Merge two deals
+//
+// Merges a deal with another deal. The deal with mergeWithId survives.
+// See MergeOrganizations.
+//
+// https://developers.pipedrive.com/docs/api/v1/Deals#mergeDeals
+func (p *Pipedrive) MergeDeals(id int, mergeWithId int) (*MergePreview, error) {
+	return p.previewMerge("deals", id, mergeWithId, p.GetDeal)
+}
@@ -0,0 +1,280 @@
+package pipedrive
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Tags which break the line when rendered as plain text
+var htmlBlockTags = map[string]bool{
+	"p": true, "div": true, "br": true, "ul": true, "ol": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"blockquote": true, "pre": true, "tr": true, "table": true, "hr": true,
+}
+
+// Converts HTML into plain text.
+//
+// All the tags are stripped, block level tags are replaced by line breaks,
+// list items are prefixed with dash and entities are decoded. Content of
+// script and style tags is dropped.
+func htmlToText(src string) string {
+	var out strings.Builder
+	skip := ""
+
+	for len(src) > 0 {
+		start := strings.IndexByte(src, '<')
+		if start < 0 {
+			if skip == "" {
+				out.WriteString(src)
+			}
+			break
+		}
+
+		if skip == "" {
+			out.WriteString(src[:start])
+		}
+
+		end := htmlTagEnd(src[start:])
+		if end < 0 {
+			// Not a tag, just a lonely "<"
+			if skip == "" {
+				out.WriteString(src[start:])
+			}
+			break
+		}
+
+		tag := src[start+1 : start+end]
+		src = src[start+end+1:]
+
+		closing := strings.HasPrefix(tag, "/")
+		name := strings.ToLower(strings.Trim(tag, "/ "))
+		if idx := strings.IndexAny(name, " \t\r\n/"); idx >= 0 {
+			name = name[:idx]
+		}
+
+		if skip != "" {
+			if closing && name == skip {
+				skip = ""
+			}
+			continue
+		}
+
+		if !closing && (name == "script" || name == "style") {
+			skip = name
+			continue
+		}
+
+		if name == "li" {
+			if !closing {
+				out.WriteString("\n- ")
+			}
+		} else if htmlBlockTags[name] {
+			out.WriteString("\n")
+		}
+	}
+
+	text := html.UnescapeString(out.String())
+	text = strings.ReplaceAll(text, "\u00a0", " ")
+
+	lines := strings.Split(text, "\n")
+	result := make([]string, 0, len(lines))
+	blank := false
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			if !blank && len(result) > 0 {
+				result = append(result, "")
+			}
+			blank = true
+			continue
+		}
+
+		blank = false
+		result = append(result, line)
+	}
+
+	return strings.TrimSpace(strings.Join(result, "\n"))
+}
+
+// Returns the index of ">" which closes the tag at the start of src, or -1.
+// Quoted attribute values may contain ">".
+func htmlTagEnd(src string) int {
+	var quote byte
+	for idx := 1; idx < len(src); idx++ {
+		ch := src[idx]
+		switch {
+		case quote != 0:
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '"' || ch == '\'':
+			quote = ch
+		case ch == '>':
+			return idx
+		}
+	}
+
+	return -1
+}
+
+var (
+	mdHeading    = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*$`)
+	mdUnordered  = regexp.MustCompile(`^\s*[-*+]\s+(.*)$`)
+	mdOrdered    = regexp.MustCompile(`^\s*\d+[.)]\s+(.*)$`)
+	mdQuote      = regexp.MustCompile(`^>\s?(.*)$`)
+	mdLink       = regexp.MustCompile(`\[([^\]]+)\]\(((?:[^()\s]|\([^()\s]*\))+)\)`)
+	mdBold       = regexp.MustCompile(`\*\*(.+?)\*\*|__(.+?)__`)
+	mdItalic     = regexp.MustCompile(`\*([^*]+)\*|\b_([^_]+)_\b`)
+	mdSafeScheme = regexp.MustCompile(`^(?i)(https?:|mailto:|tel:|/|#)`)
+)
+
+// Builds note content from Markdown.
+//
+// Supports the subset of Markdown which can be rendered by Pipedrive notes:
+// headings, paragraphs, ordered and unordered lists, block quotes, fenced code
+// blocks, inline code, bold, italic and links. Any HTML in the source is escaped.
+func NoteContentFromMarkdown(md string) string {
+	var out strings.Builder
+	var para []string
+	list := ""
+	inCode := false
+
+	flushPara := func() {
+		if len(para) > 0 {
+			out.WriteString("<p>" + strings.Join(para, "<br>") + "</p>")
+			para = nil
+		}
+	}
+
+	closeList := func() {
+		if list != "" {
+			out.WriteString("</" + list + ">")
+			list = ""
+		}
+	}
+
+	openList := func(kind string) {
+		if list != kind {
+			closeList()
+			out.WriteString("<" + kind + ">")
+			list = kind
+		}
+	}
+
+	md = strings.ReplaceAll(md, "\r\n", "\n")
+	for _, line := range strings.Split(md, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			if inCode {
+				out.WriteString("</code></pre>")
+			} else {
+				flushPara()
+				closeList()
+				out.WriteString("<pre><code>")
+			}
+			inCode = !inCode
+			continue
+		}
+
+		if inCode {
+			out.WriteString(html.EscapeString(line) + "\n")
+			continue
+		}
+
+		if strings.TrimSpace(line) == "" {
+			flushPara()
+			closeList()
+			continue
+		}
+
+		if m := mdHeading.FindStringSubmatch(line); m != nil {
+			flushPara()
+			closeList()
+			lvl := string(rune('0' + len(m[1])))
+			out.WriteString("<h" + lvl + ">" + markdownInline(m[2]) + "</h" + lvl + ">")
+			continue
+		}
+
+		if m := mdUnordered.FindStringSubmatch(line); m != nil {
+			flushPara()
+			openList("ul")
+			out.WriteString("<li>" + markdownInline(m[1]) + "</li>")
+			continue
+		}
+
+		if m := mdOrdered.FindStringSubmatch(line); m != nil {
+			flushPara()
+			openList("ol")
+			out.WriteString("<li>" + markdownInline(m[1]) + "</li>")
+			continue
+		}
+
+		if m := mdQuote.FindStringSubmatch(line); m != nil {
+			flushPara()
+			closeList()
+			out.WriteString("<blockquote>" + markdownInline(m[1]) + "</blockquote>")
+			continue
+		}
+
+		closeList()
+		para = append(para, markdownInline(strings.TrimSpace(line)))
+	}
+
+	if inCode {
+		out.WriteString("</code></pre>")
+	}
+
+	flushPara()
+	closeList()
+
+	return out.String()
+}
+
+// Renders inline Markdown of a single line
+func markdownInline(src string) string {
+	// Odd parts are code spans, they are not formatted
+	parts := strings.Split(src, "`")
+	if len(parts)%2 == 0 {
+		// Unbalanced backtick, treat the last one literally
+		parts[len(parts)-2] += "`" + parts[len(parts)-1]
+		parts = parts[:len(parts)-1]
+	}
+
+	var out strings.Builder
+	for idx, part := range parts {
+		part = html.EscapeString(part)
+		if idx%2 == 1 {
+			out.WriteString("<code>" + part + "</code>")
+			continue
+		}
+
+		// Links are replaced by placeholders, so the emphasis can wrap them
+		// while the link targets are kept intact
+		part = strings.ReplaceAll(part, "\x00", "")
+		links := []string{}
+		part = mdLink.ReplaceAllStringFunc(part, func(m string) string {
+			sub := mdLink.FindStringSubmatch(m)
+			text := markdownEmphasis(sub[1])
+			if mdSafeScheme.MatchString(html.UnescapeString(sub[2])) {
+				text = `<a href="` + sub[2] + `">` + text + "</a>"
+			}
+			links = append(links, text)
+			return "\x00" + strconv.Itoa(len(links)-1) + "\x00"
+		})
+
+		part = markdownEmphasis(part)
+		for n, link := range links {
+			part = strings.Replace(part, "\x00"+strconv.Itoa(n)+"\x00", link, 1)
+		}
+		out.WriteString(part)
+	}
+
+	return out.String()
+}
+
+// Renders bold and italic
+func markdownEmphasis(src string) string {
+	src = mdBold.ReplaceAllString(src, "<b>$1$2</b>")
+	return mdItalic.ReplaceAllString(src, "<i>$1$2</i>")
+}
@@ -0,0 +1,21 @@
+package pipedrive
+
+import (
+	"net/http"
+)
+
+// Get all note fields
+//
+// https://developers.pipedrive.com/docs/api/v1/NoteFields#getNoteFields
+func (p *Pipedrive) GetNoteFields() (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("noteFields")
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
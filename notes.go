@@ -0,0 +1,473 @@
+package pipedrive
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Pin flag enumeration
+type NotePinFlag int
+
+const (
+	NotePinFlagFalse NotePinFlag = iota
+	NotePinFlagTrue
+)
+
+// Returns string value
+func (f NotePinFlag) String() string {
+	return [...]string{"0", "1"}[f]
+}
+
+type NotesFilter struct {
+	// The ID of the user whose notes to fetch. If omitted, notes by all users will be returned.
+	UserId int
+
+	// The ID of the lead which notes to fetch
+	LeadId string
+
+	// The ID of the deal which notes to fetch
+	DealId int
+
+	// The ID of the person whose notes to fetch
+	PersonId int
+
+	// The ID of the organization which notes to fetch
+	OrgId int
+
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+
+	// The field names and sorting mode separated by a comma (field_name_1 ASC,
+	// field_name_2 DESC). Supported fields: id, user_id, deal_id, person_id,
+	// org_id, content, add_time, update_time.
+	Sort string
+
+	// The date in format of YYYY-MM-DD from which notes to fetch
+	StartDate string
+
+	// The date in format of YYYY-MM-DD until which notes to fetch to
+	EndDate string
+
+	// If set, the results are filtered by note to entity pinning state
+	PinnedToLead         *NotePinFlag
+	PinnedToDeal         *NotePinFlag
+	PinnedToOrganization *NotePinFlag
+	PinnedToPerson       *NotePinFlag
+}
+
+// Related object as it is embedded into a note
+type NoteRef struct {
+	Name  string `json:"name,omitempty"`
+	Title string `json:"title,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+type Note struct {
+	Id       int    `json:"id"`
+	UserId   int    `json:"user_id"`
+	DealId   int    `json:"deal_id"`
+	PersonId int    `json:"person_id"`
+	OrgId    int    `json:"org_id"`
+	LeadId   string `json:"lead_id"`
+
+	// Raw HTML content of the note. Use PlainText to get it without markup.
+	Content string `json:"content"`
+
+	ActiveFlag           bool   `json:"active_flag"`
+	PinnedToDeal         bool   `json:"pinned_to_deal_flag"`
+	PinnedToPerson       bool   `json:"pinned_to_person_flag"`
+	PinnedToOrganization bool   `json:"pinned_to_organization_flag"`
+	PinnedToLead         bool   `json:"pinned_to_lead_flag"`
+	LastUpdateUserId     int    `json:"last_update_user_id"`
+	AddTime              string `json:"add_time"`
+	UpdateTime           string `json:"update_time"`
+
+	Deal         *NoteRef `json:"deal"`
+	Person       *NoteRef `json:"person"`
+	Organization *NoteRef `json:"organization"`
+	User         *NoteRef `json:"user"`
+}
+
+// Returns note content with HTML markup stripped
+func (n Note) PlainText() string {
+	return htmlToText(n.Content)
+}
+
+// Parameters used to add or update a note
+type NoteParams struct {
+	// The content of the note in HTML format. Subject to sanitization on the back-end.
+	Content string `json:"content,omitempty"`
+
+	// At least one of the following ids is required when adding a note
+	LeadId   string `json:"lead_id,omitempty"`
+	DealId   int    `json:"deal_id,omitempty"`
+	PersonId int    `json:"person_id,omitempty"`
+	OrgId    int    `json:"org_id,omitempty"`
+
+	// The ID of the user who will be marked as the author of the note.
+	// Only an admin can change the author.
+	UserId int `json:"user_id,omitempty"`
+
+	// The optional creation date & time of the note in UTC.
+	// Can be set in the past or in the future. Format: YYYY-MM-DD HH:MM:SS
+	AddTime string `json:"add_time,omitempty"`
+
+	PinnedToLead         *NotePinFlag `json:"pinned_to_lead_flag,omitempty"`
+	PinnedToDeal         *NotePinFlag `json:"pinned_to_deal_flag,omitempty"`
+	PinnedToOrganization *NotePinFlag `json:"pinned_to_organization_flag,omitempty"`
+	PinnedToPerson       *NotePinFlag `json:"pinned_to_person_flag,omitempty"`
+}
+
+// Get all notes
+//
+// Returns all notes.
+//
+// https://developers.pipedrive.com/docs/api/v1/Notes#getNotes
+func (p *Pipedrive) ListNotes(f NotesFilter) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("notes")
+
+	if f.UserId > 0 {
+		url.Query.Add("user_id", strconv.Itoa(f.UserId))
+	}
+
+	if f.LeadId != "" {
+		url.Query.Add("lead_id", f.LeadId)
+	}
+
+	if f.DealId > 0 {
+		url.Query.Add("deal_id", strconv.Itoa(f.DealId))
+	}
+
+	if f.PersonId > 0 {
+		url.Query.Add("person_id", strconv.Itoa(f.PersonId))
+	}
+
+	if f.OrgId > 0 {
+		url.Query.Add("org_id", strconv.Itoa(f.OrgId))
+	}
+
+	if f.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(f.Start))
+	}
+
+	if f.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(f.Limit))
+	}
+
+	if f.Sort != "" {
+		url.Query.Add("sort", f.Sort)
+	}
+
+	if f.StartDate != "" {
+		url.Query.Add("start_date", f.StartDate)
+	}
+
+	if f.EndDate != "" {
+		url.Query.Add("end_date", f.EndDate)
+	}
+
+	if f.PinnedToLead != nil {
+		url.Query.Add("pinned_to_lead_flag", f.PinnedToLead.String())
+	}
+
+	if f.PinnedToDeal != nil {
+		url.Query.Add("pinned_to_deal_flag", f.PinnedToDeal.String())
+	}
+
+	if f.PinnedToOrganization != nil {
+		url.Query.Add("pinned_to_organization_flag", f.PinnedToOrganization.String())
+	}
+
+	if f.PinnedToPerson != nil {
+		url.Query.Add("pinned_to_person_flag", f.PinnedToPerson.String())
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get one note
+//
+// https://developers.pipedrive.com/docs/api/v1/Notes#getNote
+func (p *Pipedrive) GetNote(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("notes/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Add a note
+//
+// Adds a new note. The note has to be attached to a lead, deal, person or organization.
+//
+// https://developers.pipedrive.com/docs/api/v1/Notes#addNote
+func (p *Pipedrive) AddNote(note NoteParams) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("notes")
+
+	if note.Content == "" {
+		return nil, errors.New("Note content is required")
+	}
+
+	if note.LeadId == "" && note.DealId <= 0 && note.PersonId <= 0 && note.OrgId <= 0 {
+		return nil, errors.New("A note has to be linked to a lead, deal, person or organization")
+	}
+
+	json_data, err := json.Marshal(note)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Update a note
+//
+// https://developers.pipedrive.com/docs/api/v1/Notes#updateNote
+func (p *Pipedrive) UpdateNote(id int, note NoteParams) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("notes/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	json_data, err := json.Marshal(note)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	req, err := http.NewRequest("PUT", url.String(), buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete a note
+//
+// https://developers.pipedrive.com/docs/api/v1/Notes#deleteNote
+func (p *Pipedrive) DeleteNote(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("notes/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+type NoteComment struct {
+	Uuid       string `json:"uuid"`
+	ActiveFlag bool   `json:"active_flag"`
+	AddTime    string `json:"add_time"`
+	UpdateTime string `json:"update_time"`
+
+	// Raw HTML content of the comment. Use PlainText to get it without markup.
+	Content string `json:"content"`
+
+	ObjectId   string `json:"object_id"`
+	ObjectType string `json:"object_type"`
+	UserId     int    `json:"user_id"`
+	UpdaterId  int    `json:"updater_id"`
+	CompanyId  int    `json:"company_id"`
+}
+
+// Returns comment content with HTML markup stripped
+func (c NoteComment) PlainText() string {
+	return htmlToText(c.Content)
+}
+
+// Pagination options
+type NoteCommentsFilter struct {
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+}
+
+// Get all comments for a note
+//
+// https://developers.pipedrive.com/docs/api/v1/Notes#getNoteComments
+func (p *Pipedrive) ListNoteComments(id int, f NoteCommentsFilter) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("notes/%d/comments", id)
+	url := p.makeApiEndpoint(ep)
+
+	if f.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(f.Start))
+	}
+
+	if f.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(f.Limit))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get one comment
+//
+// https://developers.pipedrive.com/docs/api/v1/Notes#getComment
+func (p *Pipedrive) GetNoteComment(id int, commentId string) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("notes/%d/comments/%s", id, commentId)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Add a comment to a note
+//
+// https://developers.pipedrive.com/docs/api/v1/Notes#addNoteComment
+func (p *Pipedrive) AddNoteComment(id int, content string) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("notes/%d/comments", id)
+	url := p.makeApiEndpoint(ep)
+
+	if content == "" {
+		return nil, errors.New("Comment content is required")
+	}
+
+	json_data, err := json.Marshal(map[string]interface{}{"content": content})
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Update a comment related to a note
+//
+// https://developers.pipedrive.com/docs/api/v1/Notes#updateCommentForNote
+func (p *Pipedrive) UpdateNoteComment(id int, commentId string, content string) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("notes/%d/comments/%s", id, commentId)
+	url := p.makeApiEndpoint(ep)
+
+	if content == "" {
+		return nil, errors.New("Comment content is required")
+	}
+
+	json_data, err := json.Marshal(map[string]interface{}{"content": content})
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	req, err := http.NewRequest("PUT", url.String(), buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete a comment related to a note
+//
+// https://developers.pipedrive.com/docs/api/v1/Notes#deleteComment
+func (p *Pipedrive) DeleteNoteComment(id int, commentId string) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("notes/%d/comments/%s", id, commentId)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
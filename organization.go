@@ -10,6 +10,32 @@ import (
 	"strings"
 )
 
+// Organization.
+//
+// Fields are decoded from both API v1 and v2 payloads (including webhooks),
+// related entities are decoded whether they are sent as ids or objects.
+type Organization struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+
+	// Owner of the organization. API v1 calls it owner_id in REST responses
+	// and user_id in webhooks.
+	User  IdRef `json:"user_id"`
+	Owner IdRef `json:"owner_id"`
+
+	AddTime    string `json:"add_time"`
+	UpdateTime string `json:"update_time"`
+}
+
+// Returns id of the organization owner regardless of the API version
+func (o Organization) OwnerId() int {
+	if o.Owner.Id > 0 {
+		return o.Owner.Id
+	}
+
+	return o.User.Id
+}
+
 // OrgFilter holds filtering conditions
 type OrgFilter struct {
 	// If supplied, only organizations owned by the given user will be returned.
@@ -290,7 +316,7 @@ func (d DoneStatus) String() string {
 // List activities associated with an organization
 //
 // https://developers.pipedrive.com/docs/api/v1/Organizations#getOrganizationActivities
-func (p *Pipedrive) ListActivities(id int, opt SearchOrgActivitiesOptions) (*PipedriveResponse, error) {
+func (p *Pipedrive) ListOrgActivities(id int, opt SearchOrgActivitiesOptions) (*PipedriveResponse, error) {
 	ep := fmt.Sprintf("organizations/%d/activities", id)
 	url := p.makeApiEndpoint(ep)
 
@@ -390,3 +416,346 @@ func (p *Pipedrive) ListOrgDeals(id int, opt SearchOrgDealsOptions) (*PipedriveR
 	pd_resp := p.readResponse(resp)
 	return pd_resp, nil
 }
+
+// Include deleted files enumeration
+type IncludeDeletedFiles int
+
+const (
+	IncludeDeletedFilesFalse IncludeDeletedFiles = iota
+	IncludeDeletedFilesTrue
+)
+
+// Returns string value
+func (i IncludeDeletedFiles) String() string {
+	return [...]string{"0", "1"}[i]
+}
+
+// Filter files options
+type SearchOrgFilesOptions struct {
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+
+	// When enabled, the list of files will also include deleted files.
+	// Please note that trying to download these files will not work.
+	IncludeDeleted *IncludeDeletedFiles
+
+	// The field names and sorting mode separated by a comma (field_name_1 ASC,
+	// field_name_2 DESC). Only first-level field keys are supported (no nested keys).
+	// Supported fields: id, user_id, deal_id, person_id, org_id, product_id,
+	// add_time, update_time, file_name, file_type, file_size, comment.
+	Sort string
+}
+
+// List files attached to an organization
+//
+// https://developers.pipedrive.com/docs/api/v1/Organizations#getOrganizationFiles
+func (p *Pipedrive) ListOrgFiles(id int, opt SearchOrgFilesOptions) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("organizations/%d/files", id)
+	url := p.makeApiEndpoint(ep)
+
+	if opt.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(opt.Start))
+	}
+
+	if opt.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(opt.Limit))
+	}
+
+	if opt.IncludeDeleted != nil {
+		url.Query.Add("include_deleted_files", opt.IncludeDeleted.String())
+	}
+
+	if opt.Sort != "" {
+		url.Query.Add("sort", opt.Sort)
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Flow item types enum
+type FlowItemType string
+
+const (
+	FlowItemActivity                  FlowItemType = "activity"
+	FlowItemPlannedActivity           FlowItemType = "plannedActivity"
+	FlowItemNote                      FlowItemType = "note"
+	FlowItemFile                      FlowItemType = "file"
+	FlowItemChange                    FlowItemType = "change"
+	FlowItemDeal                      FlowItemType = "deal"
+	FlowItemFollower                  FlowItemType = "follower"
+	FlowItemParticipant               FlowItemType = "participant"
+	FlowItemMailMessage               FlowItemType = "mailMessage"
+	FlowItemMailMessageWithAttachment FlowItemType = "mailMessageWithAttachment"
+	FlowItemInvoice                   FlowItemType = "invoice"
+	FlowItemActivityFile              FlowItemType = "activityFile"
+	FlowItemDocument                  FlowItemType = "document"
+)
+
+// Filter updates options
+type SearchOrgUpdatesOptions struct {
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+
+	// Whether to show custom field updates or not. If false, only updates
+	// of the default fields are returned.
+	AllChanges bool
+
+	// A list of item types to include in the result. If omitted, all types are returned.
+	Items []FlowItemType
+}
+
+// List updates about an organization
+//
+// Lists updates about an organization.
+//
+// https://developers.pipedrive.com/docs/api/v1/Organizations#getOrganizationUpdates
+func (p *Pipedrive) ListOrgUpdates(id int, opt SearchOrgUpdatesOptions) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("organizations/%d/flow", id)
+	url := p.makeApiEndpoint(ep)
+
+	if opt.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(opt.Start))
+	}
+
+	if opt.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(opt.Limit))
+	}
+
+	if opt.AllChanges == true {
+		url.Query.Add("all_changes", "1")
+	}
+
+	if len(opt.Items) > 0 {
+		items := make([]string, len(opt.Items))
+		for idx, item := range opt.Items {
+			items[idx] = string(item)
+		}
+		url.Query.Add("items", strings.Join(items, ","))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// List followers of an organization
+//
+// https://developers.pipedrive.com/docs/api/v1/Organizations#getOrganizationFollowers
+func (p *Pipedrive) ListOrgFollowers(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("organizations/%d/followers", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Pagination options
+type SearchOrgMailMessagesOptions struct {
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+}
+
+// List mail messages associated with an organization
+//
+// https://developers.pipedrive.com/docs/api/v1/Organizations#getOrganizationMailMessages
+func (p *Pipedrive) ListOrgMailMessages(id int, opt SearchOrgMailMessagesOptions) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("organizations/%d/mailMessages", id)
+	url := p.makeApiEndpoint(ep)
+
+	if opt.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(opt.Start))
+	}
+
+	if opt.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(opt.Limit))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// List permitted users
+//
+// List users permitted to access an organization.
+//
+// https://developers.pipedrive.com/docs/api/v1/Organizations#getOrganizationUsers
+func (p *Pipedrive) ListOrgPermittedUsers(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("organizations/%d/permittedUsers", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Pagination options
+type SearchOrgPersonsOptions struct {
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+}
+
+// List persons of an organization
+//
+// Lists persons associated with an organization.
+//
+// https://developers.pipedrive.com/docs/api/v1/Organizations#getOrganizationPersons
+func (p *Pipedrive) ListOrgPersons(id int, opt SearchOrgPersonsOptions) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("organizations/%d/persons", id)
+	url := p.makeApiEndpoint(ep)
+
+	if opt.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(opt.Start))
+	}
+
+	if opt.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(opt.Limit))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Add a follower to an organization
+//
+// https://developers.pipedrive.com/docs/api/v1/Organizations#addOrganizationFollower
+func (p *Pipedrive) AddOrgFollower(id int, userId int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("organizations/%d/followers", id)
+	url := p.makeApiEndpoint(ep)
+
+	if userId <= 0 {
+		return nil, errors.New("User id is required")
+	}
+
+	json_data, err := json.Marshal(map[string]interface{}{"user_id": userId})
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete a follower from an organization
+//
+// Deletes a follower from an organization. You can retrieve the followerId
+// from the ListOrgFollowers.
+//
+// https://developers.pipedrive.com/docs/api/v1/Organizations#deleteOrganizationFollower
+func (p *Pipedrive) DeleteOrgFollower(id int, followerId int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("organizations/%d/followers/%d", id, followerId)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete multiple organizations in bulk
+//
+// Marks multiple organizations as deleted.
+//
+// https://developers.pipedrive.com/docs/api/v1/Organizations#deleteOrganizations
+func (p *Pipedrive) DeleteOrganizations(ids []int) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("organizations")
+
+	if len(ids) < 1 {
+		return nil, errors.New("At least one id is required")
+	}
+
+	str_ids := make([]string, len(ids))
+	for idx, id := range ids {
+		str_ids[idx] = strconv.Itoa(id)
+	}
+	url.Query.Add("ids", strings.Join(str_ids, ","))
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
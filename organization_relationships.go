@@ -0,0 +1,279 @@
+package pipedrive
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type OrgRelationshipType string
+
+const (
+	OrgRelationshipTypeParent  OrgRelationshipType = "parent"
+	OrgRelationshipTypeRelated OrgRelationshipType = "related"
+)
+
+// Organization as it is embedded into a relationship
+type OrgRelationshipOrg struct {
+	Id          int    `json:"value"`
+	Name        string `json:"name"`
+	PeopleCount int    `json:"people_count"`
+	OwnerId     int    `json:"owner_id"`
+	Address     string `json:"address"`
+	CcEmail     string `json:"cc_email"`
+}
+
+type OrgRelationship struct {
+	Id   int                 `json:"id"`
+	Type OrgRelationshipType `json:"type"`
+
+	// For parent relationships the owner is the parent organization
+	// and the linked one is its daughter.
+	OwnerOrg  OrgRelationshipOrg `json:"rel_owner_org_id"`
+	LinkedOrg OrgRelationshipOrg `json:"rel_linked_org_id"`
+
+	// Type of the relationship as seen from the organization passed
+	// in the request (parent, daughter or related).
+	CalculatedType         string `json:"calculated_type"`
+	CalculatedRelatedOrgId int    `json:"calculated_related_org_id"`
+	RelatedOrgName         string `json:"related_organization_name"`
+
+	ActiveFlag bool   `json:"active_flag"`
+	AddTime    string `json:"add_time"`
+	UpdateTime string `json:"update_time"`
+}
+
+// Fields used to add or update a relationship
+type OrgRelationshipFields struct {
+	// The ID of the base organization for the returned calculated values
+	OrgId int `json:"org_id,omitempty"`
+
+	Type OrgRelationshipType `json:"type,omitempty"`
+
+	// The owner of the relationship. If type is parent, then the owner is
+	// the parent and the linked organization is the daughter.
+	OwnerOrgId int `json:"rel_owner_org_id,omitempty"`
+
+	// The linked organization in the relationship. If type is parent,
+	// then the linked organization is the daughter.
+	LinkedOrgId int `json:"rel_linked_org_id,omitempty"`
+}
+
+// Get all relationships for organization
+//
+// https://developers.pipedrive.com/docs/api/v1/OrganizationRelationships#getOrganizationRelationships
+func (p *Pipedrive) ListOrgRelationships(orgId int) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("organizationRelationships")
+
+	if orgId <= 0 {
+		return nil, errors.New("Organization id is required")
+	}
+
+	url.Query.Add("org_id", strconv.Itoa(orgId))
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get one organization relationship
+//
+// orgId is optional and is used as the base organization for the calculated values.
+//
+// https://developers.pipedrive.com/docs/api/v1/OrganizationRelationships#getOrganizationRelationship
+func (p *Pipedrive) GetOrgRelationship(id int, orgId int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("organizationRelationships/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	if orgId > 0 {
+		url.Query.Add("org_id", strconv.Itoa(orgId))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Create an organization relationship
+//
+// https://developers.pipedrive.com/docs/api/v1/OrganizationRelationships#addOrganizationRelationship
+func (p *Pipedrive) AddOrgRelationship(rel OrgRelationshipFields) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("organizationRelationships")
+
+	if rel.Type == "" {
+		return nil, errors.New("Relationship type is required")
+	}
+
+	if rel.OwnerOrgId <= 0 || rel.LinkedOrgId <= 0 {
+		return nil, errors.New("Both owner and linked organizations are required")
+	}
+
+	json_data, err := json.Marshal(rel)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Update an organization relationship
+//
+// https://developers.pipedrive.com/docs/api/v1/OrganizationRelationships#updateOrganizationRelationship
+func (p *Pipedrive) UpdateOrgRelationship(id int, rel OrgRelationshipFields) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("organizationRelationships/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	json_data, err := json.Marshal(rel)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	req, err := http.NewRequest("PUT", url.String(), buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete an organization relationship
+//
+// https://developers.pipedrive.com/docs/api/v1/OrganizationRelationships#deleteOrganizationRelationship
+func (p *Pipedrive) DeleteOrgRelationship(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("organizationRelationships/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Node of an organization hierarchy
+type OrgHierarchyNode struct {
+	Id   int
+	Name string
+
+	// Organization details as returned by GetOrganization
+	Details map[string]interface{}
+
+	// Daughter organizations
+	Children []*OrgHierarchyNode
+}
+
+// Get organization hierarchy
+//
+// Walks parent relationships down from the root organization and returns
+// the full tree of daughter organizations. Related (non parent) relationships
+// are ignored. Every organization is visited once, so cyclic relationships
+// do not cause endless recursion.
+func (p *Pipedrive) GetOrgHierarchy(rootId int) (*OrgHierarchyNode, error) {
+	visited := map[int]bool{}
+	return p.walkOrgHierarchy(rootId, visited)
+}
+
+func (p *Pipedrive) walkOrgHierarchy(id int, visited map[int]bool) (*OrgHierarchyNode, error) {
+	visited[id] = true
+
+	pd_resp, err := p.GetOrganization(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := pd_resp.GetDataAsMap()
+
+	if err != nil {
+		return nil, err
+	}
+
+	node := &OrgHierarchyNode{Id: id, Details: details}
+	if name, ok := details["name"].(string); ok {
+		node.Name = name
+	}
+
+	pd_resp, err = p.ListOrgRelationships(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Organization without relationships
+	if pd_resp.Status < 400 && pd_resp.Data == nil {
+		return node, nil
+	}
+
+	var rels []OrgRelationship
+	err = pd_resp.DecodeData(&rels)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rel := range rels {
+		if rel.Type != OrgRelationshipTypeParent || rel.OwnerOrg.Id != id {
+			continue
+		}
+
+		if visited[rel.LinkedOrg.Id] {
+			continue
+		}
+
+		child, err := p.walkOrgHierarchy(rel.LinkedOrg.Id, visited)
+
+		if err != nil {
+			return nil, err
+		}
+
+		node.Children = append(node.Children, child)
+	}
+
+	return node, nil
+}
@@ -0,0 +1,100 @@
+package pipedrive
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type PermissionSet struct {
+	Id              string   `json:"id"`
+	Name            string   `json:"name"`
+	Description     string   `json:"description"`
+	App             UserApp  `json:"app"`
+	Type            string   `json:"type"`
+	AssignmentCount int      `json:"assignment_count"`
+	Contents        []string `json:"contents"`
+}
+
+type PermissionSetAssignment struct {
+	UserId          int    `json:"user_id"`
+	PermissionSetId string `json:"permission_set_id"`
+	Name            string `json:"name"`
+}
+
+// Get all permission sets
+//
+// If app is empty permission sets of all apps are returned.
+//
+// https://developers.pipedrive.com/docs/api/v1/PermissionSets#getPermissionSets
+func (p *Pipedrive) ListPermissionSets(app UserApp) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("permissionSets")
+
+	if app != "" {
+		url.Query.Add("app", string(app))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get one permission set
+//
+// https://developers.pipedrive.com/docs/api/v1/PermissionSets#getPermissionSet
+func (p *Pipedrive) GetPermissionSet(id string) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("permissionSets/%s", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Pagination options
+type PermissionSetAssignmentsFilter struct {
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+}
+
+// List permission set assignments
+//
+// Returns the list of assignments for a permission set.
+//
+// https://developers.pipedrive.com/docs/api/v1/PermissionSets#getPermissionSetAssignments
+func (p *Pipedrive) ListPermissionSetAssignments(id string, f PermissionSetAssignmentsFilter) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("permissionSets/%s/assignments", id)
+	url := p.makeApiEndpoint(ep)
+
+	if f.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(f.Start))
+	}
+
+	if f.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(f.Limit))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
@@ -9,6 +9,67 @@ import (
 	"strings"
 )
 
+// Email or phone of a person
+type PersonContact struct {
+	Value   string `json:"value"`
+	Primary bool   `json:"primary"`
+	Label   string `json:"label"`
+}
+
+// Person.
+//
+// Fields are decoded from both API v1 and v2 payloads (including webhooks),
+// related entities are decoded whether they are sent as ids or objects.
+type Person struct {
+	Id        int    `json:"id"`
+	Name      string `json:"name"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+
+	Organization IdRef `json:"org_id"`
+
+	// Owner of the person. API v1 calls it owner_id in REST responses
+	// and user_id in webhooks.
+	User  IdRef `json:"user_id"`
+	Owner IdRef `json:"owner_id"`
+
+	// API v1 uses singular names for the lists of contacts, API v2 plural ones
+	Email  []PersonContact `json:"email"`
+	Emails []PersonContact `json:"emails"`
+	Phone  []PersonContact `json:"phone"`
+	Phones []PersonContact `json:"phones"`
+
+	AddTime    string `json:"add_time"`
+	UpdateTime string `json:"update_time"`
+}
+
+// Returns id of the person owner regardless of the API version
+func (p Person) OwnerId() int {
+	if p.Owner.Id > 0 {
+		return p.Owner.Id
+	}
+
+	return p.User.Id
+}
+
+// Returns emails of the person regardless of the API version
+func (p Person) AllEmails() []PersonContact {
+	if len(p.Emails) > 0 {
+		return p.Emails
+	}
+
+	return p.Email
+}
+
+// Returns phones of the person regardless of the API version
+func (p Person) AllPhones() []PersonContact {
+	if len(p.Phones) > 0 {
+		return p.Phones
+	}
+
+	return p.Phone
+}
+
 type PersonFilter struct {
 	UserId    int
 	FilterId  int
@@ -0,0 +1,153 @@
+package pipedrive
+
+import (
+	"sort"
+)
+
+// Pipeline along with its ordered stages
+type PipelineNode struct {
+	Pipeline
+	Stages []*StageNode
+}
+
+// Stage with a back reference to its pipeline
+type StageNode struct {
+	Stage
+	Pipeline *PipelineNode
+}
+
+// Returns the deal probability of the stage. If deal probability is disabled
+// for the pipeline 100 is returned, the same way Pipedrive calculates
+// weighted values in that case.
+func (s *StageNode) Probability() int {
+	if s.Pipeline != nil && !s.Pipeline.DealProbability {
+		return 100
+	}
+
+	return s.DealProbability
+}
+
+// All pipelines of the company with ordered stages
+type PipelineTopology struct {
+	// Pipelines ordered by order_nr
+	Pipelines []*PipelineNode
+
+	pipelines map[int]*PipelineNode
+	stages    map[int]*StageNode
+}
+
+// Returns pipeline by its id
+func (t *PipelineTopology) Pipeline(id int) (*PipelineNode, bool) {
+	node, ok := t.pipelines[id]
+	return node, ok
+}
+
+// Returns stage by its id
+func (t *PipelineTopology) Stage(id int) (*StageNode, bool) {
+	node, ok := t.stages[id]
+	return node, ok
+}
+
+// Returns stage of a deal.
+//
+// deal is the deal data as returned by the deals endpoints.
+func (t *PipelineTopology) DealStage(deal map[string]interface{}) (*StageNode, bool) {
+	id, ok := deal["stage_id"].(float64)
+	if !ok {
+		return nil, false
+	}
+
+	return t.Stage(int(id))
+}
+
+// Load pipeline topology
+//
+// Loads all the pipelines and stages and links them together,
+// so stage names and probabilities can be resolved by stage id.
+func (p *Pipedrive) LoadPipelineTopology() (*PipelineTopology, error) {
+	topology := &PipelineTopology{
+		pipelines: map[int]*PipelineNode{},
+		stages:    map[int]*StageNode{},
+	}
+
+	start := 0
+	for {
+		pd_resp, err := p.ListPipelines(PipelinesFilter{Start: start, Limit: 500})
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Company without pipelines
+		if pd_resp.Status < 400 && pd_resp.Data == nil {
+			break
+		}
+
+		var pipelines []Pipeline
+		err = pd_resp.DecodeData(&pipelines)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pipeline := range pipelines {
+			node := &PipelineNode{Pipeline: pipeline}
+			topology.Pipelines = append(topology.Pipelines, node)
+			topology.pipelines[pipeline.Id] = node
+		}
+
+		next, more := pd_resp.NextStart()
+		if !more {
+			break
+		}
+		start = next
+	}
+
+	start = 0
+	for {
+		pd_resp, err := p.ListStages(StagesFilter{Start: start, Limit: 500})
+
+		if err != nil {
+			return nil, err
+		}
+
+		if pd_resp.Status < 400 && pd_resp.Data == nil {
+			break
+		}
+
+		var stages []Stage
+		err = pd_resp.DecodeData(&stages)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, stage := range stages {
+			node := &StageNode{Stage: stage}
+			if pipeline, ok := topology.pipelines[stage.PipelineId]; ok {
+				node.Pipeline = pipeline
+				pipeline.Stages = append(pipeline.Stages, node)
+			}
+			topology.stages[stage.Id] = node
+		}
+
+		next, more := pd_resp.NextStart()
+		if !more {
+			break
+		}
+		start = next
+	}
+
+	sort.SliceStable(topology.Pipelines, func(i, j int) bool {
+		return topology.Pipelines[i].OrderNr < topology.Pipelines[j].OrderNr
+	})
+
+	for _, pipeline := range topology.Pipelines {
+		stages := pipeline.Stages
+		sort.SliceStable(stages, func(i, j int) bool {
+			return stages[i].OrderNr < stages[j].OrderNr
+		})
+	}
+
+	return topology, nil
+}
@@ -0,0 +1,340 @@
+package pipedrive
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Pipeline flag enumeration
+type PipelineFlag int
+
+const (
+	PipelineFlagFalse PipelineFlag = iota
+	PipelineFlagTrue
+)
+
+// Returns string value
+func (f PipelineFlag) String() string {
+	return [...]string{"0", "1"}[f]
+}
+
+type Pipeline struct {
+	Id              int    `json:"id"`
+	Name            string `json:"name"`
+	UrlTitle        string `json:"url_title"`
+	OrderNr         int    `json:"order_nr"`
+	Active          bool   `json:"active"`
+	DealProbability bool   `json:"deal_probability"`
+	Selected        bool   `json:"selected"`
+	AddTime         string `json:"add_time"`
+	UpdateTime      string `json:"update_time"`
+}
+
+// Parameters used to add or update a pipeline
+type PipelineParams struct {
+	Name string `json:"name,omitempty"`
+
+	// Whether deal probability is disabled or enabled for this pipeline
+	DealProbability *PipelineFlag `json:"deal_probability,omitempty"`
+
+	// Defines the order of pipelines. First order (order_nr=0) is the default pipeline.
+	OrderNr *int `json:"order_nr,omitempty"`
+
+	// Whether this pipeline will be made inactive (hidden) or active
+	Active *PipelineFlag `json:"active,omitempty"`
+}
+
+// Pagination options
+type PipelinesFilter struct {
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+}
+
+// Get all pipelines
+//
+// Returns data about all pipelines.
+//
+// https://developers.pipedrive.com/docs/api/v1/Pipelines#getPipelines
+func (p *Pipedrive) ListPipelines(f PipelinesFilter) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("pipelines")
+
+	if f.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(f.Start))
+	}
+
+	if f.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(f.Limit))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get one pipeline
+//
+// Returns data about a specific pipeline. Also returns the summary of the
+// deals in this pipeline across its stages. If currency is not empty, the
+// totals are converted to it.
+//
+// https://developers.pipedrive.com/docs/api/v1/Pipelines#getPipeline
+func (p *Pipedrive) GetPipeline(id int, currency string) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("pipelines/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	if currency != "" {
+		url.Query.Add("totals_convert_currency", currency)
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Add a new pipeline
+//
+// https://developers.pipedrive.com/docs/api/v1/Pipelines#addPipeline
+func (p *Pipedrive) AddPipeline(pipeline PipelineParams) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("pipelines")
+
+	if pipeline.Name == "" {
+		return nil, errors.New("Pipeline name is required")
+	}
+
+	json_data, err := json.Marshal(pipeline)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Update a pipeline
+//
+// https://developers.pipedrive.com/docs/api/v1/Pipelines#updatePipeline
+func (p *Pipedrive) UpdatePipeline(id int, pipeline PipelineParams) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("pipelines/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	json_data, err := json.Marshal(pipeline)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	req, err := http.NewRequest("PUT", url.String(), buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete a pipeline
+//
+// Marks a pipeline as deleted.
+//
+// https://developers.pipedrive.com/docs/api/v1/Pipelines#deletePipeline
+func (p *Pipedrive) DeletePipeline(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("pipelines/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Statistics period
+type PipelineStatisticsOptions struct {
+	// The start of the period. Date in format of YYYY-MM-DD.
+	StartDate string
+
+	// The end of the period. Date in format of YYYY-MM-DD.
+	EndDate string
+
+	// The ID of the user who's pipeline statistics to fetch.
+	// If omitted, the authorized user will be used.
+	UserId int
+}
+
+// Get deals conversion rates in pipeline
+//
+// Returns all stage-to-stage conversion and pipeline-to-close rates for the given time period.
+//
+// https://developers.pipedrive.com/docs/api/v1/Pipelines#getPipelineConversionStatistics
+func (p *Pipedrive) GetPipelineConversionStatistics(id int, opt PipelineStatisticsOptions) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("pipelines/%d/conversion_statistics", id)
+	return p.getPipelineStatistics(ep, opt)
+}
+
+// Get deals movements in pipeline
+//
+// Returns statistics for deals movements for the given time period.
+//
+// https://developers.pipedrive.com/docs/api/v1/Pipelines#getPipelineMovementStatistics
+func (p *Pipedrive) GetPipelineMovementStatistics(id int, opt PipelineStatisticsOptions) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("pipelines/%d/movement_statistics", id)
+	return p.getPipelineStatistics(ep, opt)
+}
+
+func (p *Pipedrive) getPipelineStatistics(ep string, opt PipelineStatisticsOptions) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint(ep)
+
+	if opt.StartDate == "" || opt.EndDate == "" {
+		return nil, errors.New("Options 'StartDate' and 'EndDate' cannot be empty")
+	}
+
+	url.Query.Add("start_date", opt.StartDate)
+	url.Query.Add("end_date", opt.EndDate)
+
+	if opt.UserId > 0 {
+		url.Query.Add("user_id", strconv.Itoa(opt.UserId))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+type PipelineDealsFilter struct {
+	// If supplied, only deals matching the given filter will be returned
+	FilterId int
+
+	// If supplied, FilterId will not be considered and only deals owned by the
+	// given user will be returned. If omitted, deals owned by the authorized user will be returned.
+	UserId int
+
+	// If supplied, FilterId and UserId will not be considered – instead,
+	// deals owned by everyone will be returned
+	Everyone bool
+
+	// If supplied, only deals within the given stage will be returned
+	StageId int
+
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+
+	// Whether to include a summary of the pipeline in the additional data or not
+	GetSummary bool
+
+	// The 3-letter currency code of any of the supported currencies. When
+	// supplied, per_stages_converted is returned in deals_summary which contains
+	// the currency-converted total amounts in the given currency per each stage.
+	// You may also set this parameter to default_currency in which case users
+	// default currency is used. Only works when GetSummary is set.
+	TotalsConvertCurrency string
+}
+
+// Get deals in a pipeline
+//
+// Lists deals in a specific pipeline across all its stages.
+//
+// https://developers.pipedrive.com/docs/api/v1/Pipelines#getPipelineDeals
+func (p *Pipedrive) ListPipelineDeals(id int, f PipelineDealsFilter) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("pipelines/%d/deals", id)
+	url := p.makeApiEndpoint(ep)
+
+	if f.FilterId > 0 {
+		url.Query.Add("filter_id", strconv.Itoa(f.FilterId))
+	}
+
+	if f.UserId > 0 {
+		url.Query.Add("user_id", strconv.Itoa(f.UserId))
+	}
+
+	if f.Everyone == true {
+		url.Query.Add("everyone", "1")
+	}
+
+	if f.StageId > 0 {
+		url.Query.Add("stage_id", strconv.Itoa(f.StageId))
+	}
+
+	if f.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(f.Start))
+	}
+
+	if f.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(f.Limit))
+	}
+
+	if f.GetSummary == true {
+		url.Query.Add("get_summary", "1")
+	}
+
+	if f.TotalsConvertCurrency != "" {
+		url.Query.Add("totals_convert_currency", f.TotalsConvertCurrency)
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
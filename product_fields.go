@@ -0,0 +1,198 @@
+package pipedrive
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type ProductFieldsFilter struct {
+	Start int
+	Limit int
+}
+
+// Product fields share field types and options with organization fields
+type ProductField struct {
+	Name    string            `json:"name,omitempty"`
+	Options *[]OrgFieldOption `json:"options,omitempty"`
+	Type    OrgFieldType      `json:"field_type,omitempty"`
+}
+
+// Get all product fields
+//
+// https://developers.pipedrive.com/docs/api/v1/ProductFields#getProductFields
+func (p *Pipedrive) GetProductFields(filter ProductFieldsFilter) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("productFields")
+
+	if filter.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(filter.Start))
+	}
+
+	if filter.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(filter.Limit))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get one product field
+//
+// https://developers.pipedrive.com/docs/api/v1/ProductFields#getProductField
+func (p *Pipedrive) GetProductField(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("productFields/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Add a new product field
+//
+// https://developers.pipedrive.com/docs/api/v1/ProductFields#addProductField
+func (p *Pipedrive) AddProductField(fld ProductField) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("productFields")
+
+	if fld.Name == "" {
+		return nil, errors.New("Field name is required")
+	}
+
+	if fld.Type == "" {
+		return nil, errors.New("Field type is required")
+	}
+
+	if (fld.Type == OrgFieldTypeSet || fld.Type == OrgFieldTypeEnum) && (fld.Options == nil || len(*fld.Options) < 1) {
+		msg := fmt.Sprintf("When field type is %v the Options field is required", fld.Type)
+		return nil, errors.New(msg)
+	}
+
+	body, err := json.Marshal(fld)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(body)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Update a product field
+//
+// https://developers.pipedrive.com/docs/api/v1/ProductFields#updateProductField
+func (p *Pipedrive) UpdateProductField(id int, fld ProductField) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("productFields/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	if fld.Type != "" {
+		return nil, errors.New("Field type cannot be changed")
+	}
+
+	json_data, err := json.Marshal(fld)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	req, err := http.NewRequest("PUT", url.String(), buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete a product field
+//
+// Marks a product field as deleted.
+//
+// https://developers.pipedrive.com/docs/api/v1/ProductFields#deleteProductField
+func (p *Pipedrive) DeleteProductField(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("productFields/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete multiple product fields in bulk
+//
+// Marks multiple fields as deleted.
+//
+// https://developers.pipedrive.com/docs/api/v1/ProductFields#deleteProductFields
+func (p *Pipedrive) DeleteProductFields(ids []int) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("productFields")
+
+	if len(ids) < 1 {
+		return nil, errors.New("At least one id is required")
+	}
+
+	str_ids := make([]string, len(ids))
+	for idx, id := range ids {
+		str_ids[idx] = strconv.Itoa(id)
+	}
+	url.Query.Add("ids", strings.Join(str_ids, ","))
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
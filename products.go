@@ -0,0 +1,640 @@
+package pipedrive
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Price of a product in a single currency
+type ProductPrice struct {
+	Id           int     `json:"id,omitempty"`
+	ProductId    int     `json:"product_id,omitempty"`
+	Price        float64 `json:"price"`
+	Currency     string  `json:"currency"`
+	Cost         float64 `json:"cost,omitempty"`
+	OverheadCost float64 `json:"overhead_cost,omitempty"`
+}
+
+// Product.
+//
+// The same model is used to add and update products, read-only fields are
+// omitted when empty.
+type Product struct {
+	Id          int    `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Code        string `json:"code,omitempty"`
+	Description string `json:"description,omitempty"`
+	Unit        string `json:"unit,omitempty"`
+
+	// The tax percentage
+	Tax float64 `json:"tax,omitempty"`
+
+	ActiveFlag *bool  `json:"active_flag,omitempty"`
+	Selectable *bool  `json:"selectable,omitempty"`
+	VisibleTo  string `json:"visible_to,omitempty"`
+
+	Owner *UserRef `json:"owner_id,omitempty"`
+
+	// Prices of the product, one per currency
+	Prices []ProductPrice `json:"prices,omitempty"`
+
+	AddTime    string `json:"add_time,omitempty"`
+	UpdateTime string `json:"update_time,omitempty"`
+}
+
+// Returns the price of the product in the given currency
+func (p Product) Price(currency string) (ProductPrice, bool) {
+	for _, price := range p.Prices {
+		if price.Currency == currency {
+			return price, true
+		}
+	}
+
+	return ProductPrice{}, false
+}
+
+type ProductsFilter struct {
+	// If supplied, only products owned by the given user will be returned
+	UserId int
+
+	// The ID of the filter to use
+	FilterId int
+
+	// An array of integers with the IDs of the products that should be returned in the response
+	Ids []int
+
+	// If supplied, only products whose name starts with the specified letter
+	// will be returned (case-insensitive)
+	FirstChar string
+
+	// If supplied, the response will return the total numbers of products in
+	// the additional_data.summary.total_count property
+	GetSummary bool
+
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+}
+
+// Get all products
+//
+// https://developers.pipedrive.com/docs/api/v1/Products#getProducts
+func (p *Pipedrive) ListProducts(f ProductsFilter) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("products")
+
+	if f.UserId > 0 {
+		url.Query.Add("user_id", strconv.Itoa(f.UserId))
+	}
+
+	if f.FilterId > 0 {
+		url.Query.Add("filter_id", strconv.Itoa(f.FilterId))
+	}
+
+	for _, id := range f.Ids {
+		url.Query.Add("ids[]", strconv.Itoa(id))
+	}
+
+	if f.FirstChar != "" {
+		url.Query.Add("first_char", f.FirstChar)
+	}
+
+	if f.GetSummary == true {
+		url.Query.Add("get_summary", "true")
+	}
+
+	if f.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(f.Start))
+	}
+
+	if f.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(f.Limit))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Product search fields enum
+type ProductSearchField int
+
+const (
+	ProductSearchInCode ProductSearchField = iota
+	ProductSearchInCustom
+	ProductSearchInName
+)
+
+// Returns enum value
+func (sf ProductSearchField) String() string {
+	return [...]string{"code", "custom_fields", "name"}[sf]
+}
+
+// Search parameters
+type SearchProductsOptions struct {
+	// The search term to look for. Minimum 2 characters (or 1 if using Exact).
+	Term string
+
+	// The fields to perform the search from. Defaults to all of them.
+	Fields []ProductSearchField
+
+	// When enabled, only full exact matches against the given term are returned.
+	// It is not case sensitive.
+	Exact bool
+
+	// When enabled, the response will include the product prices
+	IncludePrice bool
+
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+}
+
+// Search products
+//
+// Searches all products by name, code and/or custom fields.
+// This endpoint is a wrapper of /v1/itemSearch with a narrower OAuth scope.
+//
+// https://developers.pipedrive.com/docs/api/v1/Products#searchProducts
+func (p *Pipedrive) SearchProducts(opt SearchProductsOptions) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("products/search")
+	if opt.Term != "" {
+		url.Query.Add("term", opt.Term)
+	} else {
+		return nil, errors.New("Option 'Term' cannot be empty")
+	}
+
+	if len(opt.Fields) >= 1 {
+		fields := make([]string, len(opt.Fields))
+		for idx, fld := range opt.Fields {
+			fields[idx] = fld.String()
+		}
+		url.Query.Add("fields", strings.Join(fields, ","))
+	}
+
+	if opt.Exact == true {
+		url.Query.Add("exact_match", "true")
+	}
+
+	if opt.IncludePrice == true {
+		url.Query.Add("include_fields", "product.price")
+	}
+
+	if opt.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(opt.Start))
+	}
+
+	if opt.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(opt.Limit))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get one product
+//
+// https://developers.pipedrive.com/docs/api/v1/Products#getProduct
+func (p *Pipedrive) GetProduct(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("products/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Add a product
+//
+// https://developers.pipedrive.com/docs/api/v1/Products#addProduct
+func (p *Pipedrive) AddProduct(product Product) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("products")
+
+	if product.Name == "" {
+		return nil, errors.New("Product name is required")
+	}
+
+	json_data, err := json.Marshal(product)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Update a product
+//
+// Note that the prices are replaced as a whole, so all of them have to be passed.
+//
+// https://developers.pipedrive.com/docs/api/v1/Products#updateProduct
+func (p *Pipedrive) UpdateProduct(id int, product Product) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("products/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	product.Id = 0
+	json_data, err := json.Marshal(product)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	req, err := http.NewRequest("PUT", url.String(), buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete a product
+//
+// Marks a product as deleted. After 30 days, the product will be permanently deleted.
+//
+// https://developers.pipedrive.com/docs/api/v1/Products#deleteProduct
+func (p *Pipedrive) DeleteProduct(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("products/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+type SearchProductDealsOptions struct {
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+
+	// Only fetch deals with a specific status. If omitted, all not deleted deals are returned.
+	Status *DealStatus
+}
+
+// Get deals where a product is attached to
+//
+// https://developers.pipedrive.com/docs/api/v1/Products#getProductDeals
+func (p *Pipedrive) ListProductDeals(id int, opt SearchProductDealsOptions) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("products/%d/deals", id)
+	url := p.makeApiEndpoint(ep)
+
+	if opt.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(opt.Start))
+	}
+
+	if opt.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(opt.Limit))
+	}
+
+	if opt.Status != nil {
+		url.Query.Add("status", opt.Status.String())
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+type SearchProductFilesOptions struct {
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+
+	// When enabled, the list of files will also include deleted files.
+	// Please note that trying to download these files will not work.
+	IncludeDeleted *IncludeDeletedFiles
+
+	// The field names and sorting mode separated by a comma (field_name_1 ASC,
+	// field_name_2 DESC). Only first-level field keys are supported (no nested keys).
+	Sort string
+}
+
+// List files attached to a product
+//
+// https://developers.pipedrive.com/docs/api/v1/Products#getProductFiles
+func (p *Pipedrive) ListProductFiles(id int, opt SearchProductFilesOptions) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("products/%d/files", id)
+	url := p.makeApiEndpoint(ep)
+
+	if opt.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(opt.Start))
+	}
+
+	if opt.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(opt.Limit))
+	}
+
+	if opt.IncludeDeleted != nil {
+		url.Query.Add("include_deleted_files", opt.IncludeDeleted.String())
+	}
+
+	if opt.Sort != "" {
+		url.Query.Add("sort", opt.Sort)
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// List followers of a product
+//
+// https://developers.pipedrive.com/docs/api/v1/Products#getProductFollowers
+func (p *Pipedrive) ListProductFollowers(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("products/%d/followers", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Add a follower to a product
+//
+// https://developers.pipedrive.com/docs/api/v1/Products#addProductFollower
+func (p *Pipedrive) AddProductFollower(id int, userId int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("products/%d/followers", id)
+	url := p.makeApiEndpoint(ep)
+
+	if userId <= 0 {
+		return nil, errors.New("User id is required")
+	}
+
+	json_data, err := json.Marshal(map[string]interface{}{"user_id": userId})
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete a follower from a product
+//
+// https://developers.pipedrive.com/docs/api/v1/Products#deleteProductFollower
+func (p *Pipedrive) DeleteProductFollower(id int, followerId int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("products/%d/followers/%d", id, followerId)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// List permitted users
+//
+// Lists users permitted to access a product.
+//
+// https://developers.pipedrive.com/docs/api/v1/Products#getProductUsers
+func (p *Pipedrive) ListProductPermittedUsers(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("products/%d/permittedUsers", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Price of a product variation in a single currency
+type ProductVariationPrice struct {
+	Currency string  `json:"currency"`
+	Price    float64 `json:"price"`
+	Cost     float64 `json:"cost,omitempty"`
+	Notes    string  `json:"notes,omitempty"`
+}
+
+type ProductVariation struct {
+	Id        int                     `json:"id,omitempty"`
+	ProductId int                     `json:"product_id,omitempty"`
+	Name      string                  `json:"name,omitempty"`
+	Prices    []ProductVariationPrice `json:"prices,omitempty"`
+}
+
+// Pagination options
+type ProductVariationsFilter struct {
+	// For pagination, the marker (an opaque string value) representing the
+	// first item on the next page
+	Cursor string
+
+	// Items shown per page
+	Limit int
+}
+
+// Get all product variations
+//
+// Product variations are available in API v2 only.
+//
+// https://developers.pipedrive.com/docs/api/v2/Products#getProductVariations
+func (p *Pipedrive) ListProductVariations(id int, f ProductVariationsFilter) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("products/%d/variations", id)
+	url := p.makeApiV2Endpoint(ep)
+
+	if f.Cursor != "" {
+		url.Query.Add("cursor", f.Cursor)
+	}
+
+	if f.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(f.Limit))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Add a product variation
+//
+// https://developers.pipedrive.com/docs/api/v2/Products#addProductVariation
+func (p *Pipedrive) AddProductVariation(id int, variation ProductVariation) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("products/%d/variations", id)
+	url := p.makeApiV2Endpoint(ep)
+
+	if variation.Name == "" {
+		return nil, errors.New("Variation name is required")
+	}
+
+	json_data, err := json.Marshal(ProductVariation{Name: variation.Name, Prices: variation.Prices})
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Update a product variation
+//
+// https://developers.pipedrive.com/docs/api/v2/Products#updateProductVariation
+func (p *Pipedrive) UpdateProductVariation(id int, variationId int, variation ProductVariation) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("products/%d/variations/%d", id, variationId)
+	url := p.makeApiV2Endpoint(ep)
+
+	json_data, err := json.Marshal(ProductVariation{Name: variation.Name, Prices: variation.Prices})
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	req, err := http.NewRequest("PATCH", url.String(), buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete a product variation
+//
+// https://developers.pipedrive.com/docs/api/v2/Products#deleteProductVariation
+func (p *Pipedrive) DeleteProductVariation(id int, variationId int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("products/%d/variations/%d", id, variationId)
+	url := p.makeApiV2Endpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
@@ -0,0 +1,68 @@
+package pipedrive
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type ProjectTemplate struct {
+	Id              int    `json:"id"`
+	Title           string `json:"title"`
+	Description     string `json:"description"`
+	ProjectsBoardId int    `json:"projects_board_id"`
+	OwnerId         int    `json:"owner_id"`
+	AddTime         string `json:"add_time"`
+	UpdateTime      string `json:"update_time"`
+}
+
+type ProjectTemplatesFilter struct {
+	// Pagination cursor, see PipedriveResponse.NextCursor
+	Cursor string
+
+	// Items shown per page
+	Limit int
+}
+
+// Get all project templates
+//
+// Returns all not deleted project templates. This is a cursor-paginated endpoint.
+//
+// https://developers.pipedrive.com/docs/api/v1/ProjectTemplates#getProjectTemplates
+func (p *Pipedrive) ListProjectTemplates(f ProjectTemplatesFilter) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("projectTemplates")
+
+	if f.Cursor != "" {
+		url.Query.Add("cursor", f.Cursor)
+	}
+
+	if f.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(f.Limit))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get details of a template
+//
+// https://developers.pipedrive.com/docs/api/v1/ProjectTemplates#getProjectTemplate
+func (p *Pipedrive) GetProjectTemplate(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("projectTemplates/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
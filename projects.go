@@ -0,0 +1,402 @@
+package pipedrive
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type ProjectStatus string
+
+const (
+	ProjectStatusOpen      ProjectStatus = "open"
+	ProjectStatusCompleted ProjectStatus = "completed"
+	ProjectStatusCanceled  ProjectStatus = "canceled"
+	ProjectStatusDeleted   ProjectStatus = "deleted"
+)
+
+type Project struct {
+	Id               int           `json:"id"`
+	Title            string        `json:"title"`
+	BoardId          int           `json:"board_id"`
+	PhaseId          int           `json:"phase_id"`
+	Description      string        `json:"description"`
+	Status           ProjectStatus `json:"status"`
+	OwnerId          int           `json:"owner_id"`
+	StartDate        string        `json:"start_date"`
+	EndDate          string        `json:"end_date"`
+	DealIds          []int         `json:"deal_ids"`
+	OrgId            int           `json:"org_id"`
+	PersonId         int           `json:"person_id"`
+	Labels           []int         `json:"labels"`
+	AddTime          string        `json:"add_time"`
+	UpdateTime       string        `json:"update_time"`
+	StatusChangeTime string        `json:"status_change_time"`
+	ArchiveTime      string        `json:"archive_time"`
+}
+
+// Project details to add or update. Empty values are not sent.
+type ProjectParams struct {
+	Title       string        `json:"title,omitempty"`
+	BoardId     int           `json:"board_id,omitempty"`
+	PhaseId     int           `json:"phase_id,omitempty"`
+	Description string        `json:"description,omitempty"`
+	Status      ProjectStatus `json:"status,omitempty"`
+	OwnerId     int           `json:"owner_id,omitempty"`
+
+	// Format: YYYY-MM-DD
+	StartDate string `json:"start_date,omitempty"`
+	EndDate   string `json:"end_date,omitempty"`
+
+	DealIds  []int `json:"deal_ids,omitempty"`
+	OrgId    int   `json:"org_id,omitempty"`
+	PersonId int   `json:"person_id,omitempty"`
+	Labels   []int `json:"labels,omitempty"`
+
+	// Template to create the project from, used only when adding
+	TemplateId int `json:"template_id,omitempty"`
+}
+
+type ProjectBoard struct {
+	Id         int    `json:"id"`
+	Name       string `json:"name"`
+	OrderNr    int    `json:"order_nr"`
+	AddTime    string `json:"add_time"`
+	UpdateTime string `json:"update_time"`
+}
+
+type ProjectPhase struct {
+	Id         int    `json:"id"`
+	Name       string `json:"name"`
+	BoardId    int    `json:"board_id"`
+	OrderNr    int    `json:"order_nr"`
+	AddTime    string `json:"add_time"`
+	UpdateTime string `json:"update_time"`
+}
+
+type ProjectGroup struct {
+	Id      int    `json:"id"`
+	Name    string `json:"name"`
+	OrderNr int    `json:"order_nr"`
+}
+
+// Type of a project plan item
+type ProjectPlanItemType string
+
+const (
+	ProjectPlanTask     ProjectPlanItemType = "task"
+	ProjectPlanActivity ProjectPlanItemType = "activity"
+)
+
+// Position of a task or an activity in the project plan
+type ProjectPlanItem struct {
+	ItemId   int                 `json:"item_id"`
+	ItemType ProjectPlanItemType `json:"item_type"`
+	PhaseId  int                 `json:"phase_id"`
+	GroupId  int                 `json:"group_id"`
+}
+
+type ProjectsFilter struct {
+	// Pagination cursor, see PipedriveResponse.NextCursor
+	Cursor string
+
+	// Items shown per page
+	Limit int
+
+	// The ID of the filter to use
+	Filter int
+
+	// Only projects with the given statuses are returned
+	Status []ProjectStatus
+
+	// Only projects in the given phase are returned
+	Phase int
+
+	IncludeArchived bool
+}
+
+// Get all projects
+//
+// Returns all projects. This is a cursor-paginated endpoint.
+//
+// https://developers.pipedrive.com/docs/api/v1/Projects#getProjects
+func (p *Pipedrive) ListProjects(f ProjectsFilter) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("projects")
+
+	if f.Cursor != "" {
+		url.Query.Add("cursor", f.Cursor)
+	}
+
+	if f.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(f.Limit))
+	}
+
+	if f.Filter > 0 {
+		url.Query.Add("filter_id", strconv.Itoa(f.Filter))
+	}
+
+	if len(f.Status) > 0 {
+		statuses := make([]string, len(f.Status))
+		for idx, status := range f.Status {
+			statuses[idx] = string(status)
+		}
+		url.Query.Add("status", strings.Join(statuses, ","))
+	}
+
+	if f.Phase > 0 {
+		url.Query.Add("phase_id", strconv.Itoa(f.Phase))
+	}
+
+	if f.IncludeArchived {
+		url.Query.Add("include_archived", "true")
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get details of a project
+//
+// https://developers.pipedrive.com/docs/api/v1/Projects#getProject
+func (p *Pipedrive) GetProject(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("projects/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Add a project
+//
+// Adds a new project. Title, BoardId and PhaseId are required.
+//
+// https://developers.pipedrive.com/docs/api/v1/Projects#addProject
+func (p *Pipedrive) AddProject(params ProjectParams) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("projects")
+
+	if params.Title == "" {
+		return nil, errors.New("Project title is required")
+	}
+
+	if params.BoardId <= 0 || params.PhaseId <= 0 {
+		return nil, errors.New("Board id and phase id are required")
+	}
+
+	return p.sendJson("POST", url, params)
+}
+
+// Update a project
+//
+// Updates a project. Empty values are left untouched.
+//
+// https://developers.pipedrive.com/docs/api/v1/Projects#updateProject
+func (p *Pipedrive) UpdateProject(id int, params ProjectParams) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("projects/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	params.TemplateId = 0
+
+	return p.sendJson("PUT", url, params)
+}
+
+// Delete a project
+//
+// Marks a project as deleted.
+//
+// https://developers.pipedrive.com/docs/api/v1/Projects#deleteProject
+func (p *Pipedrive) DeleteProject(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("projects/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Archive a project
+//
+// https://developers.pipedrive.com/docs/api/v1/Projects#archiveProject
+func (p *Pipedrive) ArchiveProject(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("projects/%d/archive", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Post(url.String(), "application/json", strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Returns project plan
+//
+// Returns information about items in a project plan. Items consists of
+// tasks and activities and are linked to specific project phase and group.
+//
+// https://developers.pipedrive.com/docs/api/v1/Projects#getProjectPlan
+func (p *Pipedrive) GetProjectPlan(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("projects/%d/plan", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Update activity or task in project plan
+//
+// Moves the item to the phase and the group. Zero phaseId or groupId
+// are left untouched.
+//
+// https://developers.pipedrive.com/docs/api/v1/Projects#putProjectPlanActivity
+// https://developers.pipedrive.com/docs/api/v1/Projects#putProjectPlanTask
+func (p *Pipedrive) UpdateProjectPlanItem(id int, itemType ProjectPlanItemType, itemId int, phaseId int, groupId int) (*PipedriveResponse, error) {
+	var ep string
+
+	switch itemType {
+	case ProjectPlanTask:
+		ep = fmt.Sprintf("projects/%d/plan/tasks/%d", id, itemId)
+	case ProjectPlanActivity:
+		ep = fmt.Sprintf("projects/%d/plan/activities/%d", id, itemId)
+	default:
+		return nil, errors.New("Unknown plan item type")
+	}
+
+	url := p.makeApiEndpoint(ep)
+
+	body := map[string]interface{}{}
+
+	if phaseId > 0 {
+		body["phase_id"] = phaseId
+	}
+
+	if groupId > 0 {
+		body["group_id"] = groupId
+	}
+
+	return p.sendJson("PUT", url, body)
+}
+
+// Returns project groups
+//
+// https://developers.pipedrive.com/docs/api/v1/Projects#getProjectGroups
+func (p *Pipedrive) ListProjectGroups(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("projects/%d/groups", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Returns project tasks
+//
+// https://developers.pipedrive.com/docs/api/v1/Projects#getProjectTasks
+func (p *Pipedrive) ListProjectTasks(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("projects/%d/tasks", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Returns project activities
+//
+// https://developers.pipedrive.com/docs/api/v1/Projects#getProjectActivities
+func (p *Pipedrive) ListProjectActivities(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("projects/%d/activities", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get all project boards
+//
+// https://developers.pipedrive.com/docs/api/v1/Projects#getProjectsBoards
+func (p *Pipedrive) ListProjectBoards() (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("projects/boards")
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get project phases
+//
+// Returns all active project phases under a specific board.
+//
+// https://developers.pipedrive.com/docs/api/v1/Projects#getProjectsPhases
+func (p *Pipedrive) ListProjectPhases(boardId int) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("projects/phases")
+
+	if boardId <= 0 {
+		return nil, errors.New("Board id is required")
+	}
+
+	url.Query.Add("board_id", strconv.Itoa(boardId))
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
@@ -0,0 +1,108 @@
+package pipedrive
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Format of the recents timestamps
+const RecentsTimeFormat = "2006-01-02 15:04:05"
+
+// Types of the recent items
+type RecentsItem string
+
+const (
+	RecentsActivity     RecentsItem = "activity"
+	RecentsActivityType RecentsItem = "activityType"
+	RecentsDeal         RecentsItem = "deal"
+	RecentsFile         RecentsItem = "file"
+	RecentsFilter       RecentsItem = "filter"
+	RecentsNote         RecentsItem = "note"
+	RecentsPerson       RecentsItem = "person"
+	RecentsOrganization RecentsItem = "organization"
+	RecentsPipeline     RecentsItem = "pipeline"
+	RecentsProduct      RecentsItem = "product"
+	RecentsStage        RecentsItem = "stage"
+	RecentsUser         RecentsItem = "user"
+)
+
+// Changed entity
+type RecentChange struct {
+	Item RecentsItem `json:"item"`
+	Id   int         `json:"id"`
+
+	// The entity as returned by its Get endpoint
+	Data json.RawMessage `json:"data"`
+}
+
+// Decodes the entity into v
+func (c RecentChange) Decode(v interface{}) error {
+	if len(c.Data) == 0 || string(c.Data) == "null" {
+		return errors.New("No data in the change")
+	}
+
+	return json.Unmarshal(c.Data, v)
+}
+
+type RecentsOptions struct {
+	// Types of the items to return, empty means all types
+	Items []RecentsItem
+
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+}
+
+// Get recents
+//
+// Returns data about all recent changes occurred after the given timestamp.
+// Format of since: YYYY-MM-DD HH:MM:SS in UTC, see RecentsTimeFormat.
+//
+// https://developers.pipedrive.com/docs/api/v1/Recents#getRecents
+func (p *Pipedrive) ListRecents(since string, opt RecentsOptions) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("recents")
+
+	if since == "" {
+		return nil, errors.New("Since timestamp is required")
+	}
+
+	url.Query.Add("since_timestamp", since)
+
+	if len(opt.Items) > 0 {
+		items := make([]string, len(opt.Items))
+		for idx, item := range opt.Items {
+			items[idx] = string(item)
+		}
+		url.Query.Add("items", strings.Join(items, ","))
+	}
+
+	if opt.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(opt.Start))
+	}
+
+	if opt.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(opt.Limit))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Formats time as a recents timestamp
+func RecentsTimestamp(t time.Time) string {
+	return t.UTC().Format(RecentsTimeFormat)
+}
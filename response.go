@@ -1,6 +1,10 @@
 package pipedrive
 
-import "errors"
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+)
 
 type PipedriveResponse struct {
 	Success    bool        `json:"success,omitempty"`
@@ -9,6 +13,8 @@ type PipedriveResponse struct {
 	ErrorInfo  string      `json:"error_info,omitempty"`
 	Data       interface{} `json:"data,omitempty"`
 	RemainHits int32
+
+	AdditionalData map[string]interface{} `json:"additional_data,omitempty"`
 }
 
 func (r PipedriveResponse) GetDataAsList() ([]map[string]interface{}, error) {
@@ -62,3 +68,145 @@ func (r PipedriveResponse) GetDataAsMap() (map[string]interface{}, error) {
 
 	return nil, errors.New("Unexpected data type")
 }
+
+// Decodes response data into v.
+//
+// v should be a pointer to a struct (or a slice of structs) with json tags,
+// the same way it is passed to json.Unmarshal.
+func (r PipedriveResponse) DecodeData(v interface{}) error {
+	if r.Status >= 400 {
+		return errors.New(r.ErrorMsg)
+	}
+
+	if r.Status < 400 && r.Data == nil {
+		return errors.New("No data returned")
+	}
+
+	raw, err := json.Marshal(r.Data)
+
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(raw, v)
+}
+
+// Returns the start of the next page.
+//
+// The second value is false when there are no more items in collection
+// or the response is not paginated.
+func (r PipedriveResponse) NextStart() (int, bool) {
+	pagination, ok := r.AdditionalData["pagination"].(map[string]interface{})
+	if !ok {
+		return 0, false
+	}
+
+	more, _ := pagination["more_items_in_collection"].(bool)
+	if !more {
+		return 0, false
+	}
+
+	next, ok := pagination["next_start"].(float64)
+	if !ok {
+		return 0, false
+	}
+
+	return int(next), true
+}
+
+// Returns the cursor of the next page of cursor paginated endpoints.
+//
+// The second value is false when there are no more items.
+func (r PipedriveResponse) NextCursor() (string, bool) {
+	cursor, _ := r.AdditionalData["next_cursor"].(string)
+	return cursor, cursor != ""
+}
+
+// Reference to a related entity.
+//
+// Depending on the endpoint and the API version Pipedrive returns related
+// entities either as a plain id or as an object with the id in "value" or
+// "id" along with some details. All the forms are decoded into IdRef, when
+// encoded only the id is sent.
+type IdRef struct {
+	Id   int
+	Name string
+}
+
+func (r *IdRef) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '{' {
+		var obj struct {
+			Value *int   `json:"value"`
+			Id    *int   `json:"id"`
+			Name  string `json:"name"`
+		}
+
+		err := json.Unmarshal(data, &obj)
+
+		if err != nil {
+			return err
+		}
+
+		if obj.Value != nil {
+			r.Id = *obj.Value
+		} else if obj.Id != nil {
+			r.Id = *obj.Id
+		}
+		r.Name = obj.Name
+
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var str string
+		err := json.Unmarshal(data, &str)
+
+		if err != nil {
+			return err
+		}
+
+		if str == "" {
+			return nil
+		}
+
+		r.Id, err = strconv.Atoi(str)
+		return err
+	}
+
+	return json.Unmarshal(data, &r.Id)
+}
+
+func (r IdRef) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.Id)
+}
+
+// Boolean flag.
+//
+// Some endpoints return flags as 0 and 1, others as booleans. Both forms
+// are decoded into Flag, when encoded it is sent as 0 or 1.
+type Flag bool
+
+func (f *Flag) UnmarshalJSON(data []byte) error {
+	switch string(data) {
+	case "null", "0", "false", `"0"`, `""`:
+		*f = false
+	case "1", "true", `"1"`:
+		*f = true
+	default:
+		return errors.New("Invalid flag value: " + string(data))
+	}
+
+	return nil
+}
+
+func (f Flag) MarshalJSON() ([]byte, error) {
+	if f {
+		return []byte("1"), nil
+	}
+
+	return []byte("0"), nil
+}
@@ -0,0 +1,422 @@
+package pipedrive
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type Role struct {
+	Id           int    `json:"id"`
+	ParentRoleId int    `json:"parent_role_id"`
+	Name         string `json:"name"`
+	ActiveFlag   bool   `json:"active_flag"`
+	Level        int    `json:"level"`
+}
+
+type RoleAssignment struct {
+	UserId       int    `json:"user_id"`
+	RoleId       int    `json:"role_id"`
+	ParentRoleId int    `json:"parent_role_id"`
+	Name         string `json:"name"`
+	ActiveFlag   bool   `json:"active_flag"`
+	Type         string `json:"type"`
+}
+
+// Visibility and access levels of a role
+type RoleSettings struct {
+	DealDefaultVisibility    int `json:"deal_default_visibility"`
+	LeadDefaultVisibility    int `json:"lead_default_visibility"`
+	OrgDefaultVisibility     int `json:"org_default_visibility"`
+	PersonDefaultVisibility  int `json:"person_default_visibility"`
+	ProductDefaultVisibility int `json:"product_default_visibility"`
+	DealAccessLevel          int `json:"deal_access_level"`
+	OrgAccessLevel           int `json:"org_access_level"`
+	PersonAccessLevel        int `json:"person_access_level"`
+	ProductAccessLevel       int `json:"product_access_level"`
+}
+
+// Pipelines visibility of a role
+type RolePipelines struct {
+	PipelineIds []int `json:"pipeline_ids"`
+	Visible     bool  `json:"visible"`
+}
+
+// Pagination options
+type RolesFilter struct {
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+}
+
+// Get all roles
+//
+// https://developers.pipedrive.com/docs/api/v1/Roles#getRoles
+func (p *Pipedrive) ListRoles(f RolesFilter) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("roles")
+
+	if f.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(f.Start))
+	}
+
+	if f.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(f.Limit))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get one role
+//
+// https://developers.pipedrive.com/docs/api/v1/Roles#getRole
+func (p *Pipedrive) GetRole(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("roles/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Add a role
+//
+// If parentRoleId is 0 the role is added to the top level.
+//
+// https://developers.pipedrive.com/docs/api/v1/Roles#addRole
+func (p *Pipedrive) AddRole(name string, parentRoleId int) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("roles")
+
+	if name == "" {
+		return nil, errors.New("Role name is required")
+	}
+
+	body := map[string]interface{}{"name": name}
+
+	if parentRoleId > 0 {
+		body["parent_role_id"] = parentRoleId
+	}
+
+	json_data, err := json.Marshal(body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Update role details
+//
+// Empty name and zero parentRoleId are left untouched.
+//
+// https://developers.pipedrive.com/docs/api/v1/Roles#updateRole
+func (p *Pipedrive) UpdateRole(id int, name string, parentRoleId int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("roles/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	body := map[string]interface{}{}
+
+	if name != "" {
+		body["name"] = name
+	}
+
+	if parentRoleId > 0 {
+		body["parent_role_id"] = parentRoleId
+	}
+
+	json_data, err := json.Marshal(body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	req, err := http.NewRequest("PUT", url.String(), buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete a role
+//
+// Marks a role as deleted.
+//
+// https://developers.pipedrive.com/docs/api/v1/Roles#deleteRole
+func (p *Pipedrive) DeleteRole(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("roles/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// List role assignments
+//
+// Returns all users assigned to a role.
+//
+// https://developers.pipedrive.com/docs/api/v1/Roles#getRoleAssignments
+func (p *Pipedrive) ListRoleAssignments(id int, f RolesFilter) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("roles/%d/assignments", id)
+	url := p.makeApiEndpoint(ep)
+
+	if f.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(f.Start))
+	}
+
+	if f.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(f.Limit))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Add role assignment
+//
+// Assigns a user to a role.
+//
+// https://developers.pipedrive.com/docs/api/v1/Roles#addRoleAssignment
+func (p *Pipedrive) AddRoleAssignment(id int, userId int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("roles/%d/assignments", id)
+	url := p.makeApiEndpoint(ep)
+
+	if userId <= 0 {
+		return nil, errors.New("User id is required")
+	}
+
+	json_data, err := json.Marshal(map[string]interface{}{"user_id": userId})
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete a role assignment
+//
+// Removes the assigned user from a role and adds to the default role.
+//
+// https://developers.pipedrive.com/docs/api/v1/Roles#deleteRoleAssignment
+func (p *Pipedrive) DeleteRoleAssignment(id int, userId int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("roles/%d/assignments", id)
+	url := p.makeApiEndpoint(ep)
+
+	if userId <= 0 {
+		return nil, errors.New("User id is required")
+	}
+
+	json_data, err := json.Marshal(map[string]interface{}{"user_id": userId})
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	req, err := http.NewRequest("DELETE", url.String(), buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// List role settings
+//
+// Returns the visibility settings of a specific role.
+//
+// https://developers.pipedrive.com/docs/api/v1/Roles#getRoleSettings
+func (p *Pipedrive) ListRoleSettings(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("roles/%d/settings", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Role setting keys
+type RoleSettingKey string
+
+const (
+	RoleSettingDealDefaultVisibility    RoleSettingKey = "deal_default_visibility"
+	RoleSettingLeadDefaultVisibility    RoleSettingKey = "lead_default_visibility"
+	RoleSettingOrgDefaultVisibility     RoleSettingKey = "org_default_visibility"
+	RoleSettingPersonDefaultVisibility  RoleSettingKey = "person_default_visibility"
+	RoleSettingProductDefaultVisibility RoleSettingKey = "product_default_visibility"
+)
+
+// Add or update role setting
+//
+// https://developers.pipedrive.com/docs/api/v1/Roles#addOrUpdateRoleSetting
+func (p *Pipedrive) SetRoleSetting(id int, key RoleSettingKey, value int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("roles/%d/settings", id)
+	url := p.makeApiEndpoint(ep)
+
+	if key == "" {
+		return nil, errors.New("Setting key is required")
+	}
+
+	body := map[string]interface{}{
+		"setting_key": key,
+		"value":       value,
+	}
+
+	json_data, err := json.Marshal(body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// List pipeline visibility for a role
+//
+// Returns the pipelines which are visible (or hidden when visible is false) for the role.
+//
+// https://developers.pipedrive.com/docs/api/v1/Roles#getRolePipelines
+func (p *Pipedrive) ListRolePipelines(id int, visible bool) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("roles/%d/pipelines", id)
+	url := p.makeApiEndpoint(ep)
+
+	url.Query.Add("visible", strconv.FormatBool(visible))
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Update pipeline visibility for a role
+//
+// https://developers.pipedrive.com/docs/api/v1/Roles#updateRolePipelines
+func (p *Pipedrive) UpdateRolePipelines(id int, pipelineIds []int, visible bool) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("roles/%d/pipelines", id)
+	url := p.makeApiEndpoint(ep)
+
+	if len(pipelineIds) < 1 {
+		return nil, errors.New("At least one pipeline id is required")
+	}
+
+	json_data, err := json.Marshal(RolePipelines{PipelineIds: pipelineIds, Visible: visible})
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	req, err := http.NewRequest("PUT", url.String(), buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
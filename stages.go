@@ -0,0 +1,302 @@
+package pipedrive
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type Stage struct {
+	Id         int    `json:"id"`
+	OrderNr    int    `json:"order_nr"`
+	Name       string `json:"name"`
+	ActiveFlag bool   `json:"active_flag"`
+
+	// Deal success probability percentage
+	DealProbability int `json:"deal_probability"`
+
+	PipelineId              int    `json:"pipeline_id"`
+	PipelineName            string `json:"pipeline_name"`
+	PipelineDealProbability bool   `json:"pipeline_deal_probability"`
+	RottenFlag              bool   `json:"rotten_flag"`
+	RottenDays              int    `json:"rotten_days"`
+	AddTime                 string `json:"add_time"`
+	UpdateTime              string `json:"update_time"`
+}
+
+// Parameters used to add or update a stage
+type StageParams struct {
+	Name       string `json:"name,omitempty"`
+	PipelineId int    `json:"pipeline_id,omitempty"`
+
+	// The success probability percentage of the deal.
+	// Used/shown when deal weighted values are used.
+	DealProbability *int `json:"deal_probability,omitempty"`
+
+	// Whether deals in this stage can become rotten
+	RottenFlag *bool `json:"rotten_flag,omitempty"`
+
+	// The number of days the deals not updated in this stage would become rotten.
+	// Applies only if the RottenFlag is set.
+	RottenDays *int `json:"rotten_days,omitempty"`
+
+	// An order number for this stage. Order numbers should be used to order
+	// the stages in the pipeline. Used only on update.
+	OrderNr *int `json:"order_nr,omitempty"`
+}
+
+type StagesFilter struct {
+	// The ID of the pipeline to fetch stages for. If omitted, stages for all pipelines will be fetched.
+	PipelineId int
+
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+}
+
+// Get all stages
+//
+// Returns data about all stages.
+//
+// https://developers.pipedrive.com/docs/api/v1/Stages#getStages
+func (p *Pipedrive) ListStages(f StagesFilter) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("stages")
+
+	if f.PipelineId > 0 {
+		url.Query.Add("pipeline_id", strconv.Itoa(f.PipelineId))
+	}
+
+	if f.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(f.Start))
+	}
+
+	if f.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(f.Limit))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get one stage
+//
+// Returns data about a specific stage.
+// If everyone is set, the deals summary is calculated for all users.
+//
+// https://developers.pipedrive.com/docs/api/v1/Stages#getStage
+func (p *Pipedrive) GetStage(id int, everyone bool) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("stages/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	if everyone == true {
+		url.Query.Add("everyone", "1")
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Add a new stage
+//
+// https://developers.pipedrive.com/docs/api/v1/Stages#addStage
+func (p *Pipedrive) AddStage(stage StageParams) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("stages")
+
+	if stage.Name == "" {
+		return nil, errors.New("Stage name is required")
+	}
+
+	if stage.PipelineId <= 0 {
+		return nil, errors.New("Pipeline id is required")
+	}
+
+	json_data, err := json.Marshal(stage)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Update stage details
+//
+// https://developers.pipedrive.com/docs/api/v1/Stages#updateStage
+func (p *Pipedrive) UpdateStage(id int, stage StageParams) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("stages/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	json_data, err := json.Marshal(stage)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	req, err := http.NewRequest("PUT", url.String(), buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete a stage
+//
+// Marks a stage as deleted.
+//
+// https://developers.pipedrive.com/docs/api/v1/Stages#deleteStage
+func (p *Pipedrive) DeleteStage(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("stages/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete multiple stages in bulk
+//
+// Marks multiple stages as deleted.
+//
+// https://developers.pipedrive.com/docs/api/v1/Stages#deleteStages
+func (p *Pipedrive) DeleteStages(ids []int) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("stages")
+
+	if len(ids) < 1 {
+		return nil, errors.New("At least one id is required")
+	}
+
+	str_ids := make([]string, len(ids))
+	for idx, id := range ids {
+		str_ids[idx] = strconv.Itoa(id)
+	}
+	url.Query.Add("ids", strings.Join(str_ids, ","))
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+type StageDealsFilter struct {
+	// If supplied, only deals matching the given filter will be returned
+	FilterId int
+
+	// If supplied, FilterId will not be considered and only deals owned by the
+	// given user will be returned. If omitted, deals owned by the authorized user will be returned.
+	UserId int
+
+	// If supplied, FilterId and UserId will not be considered – instead,
+	// deals owned by everyone will be returned
+	Everyone bool
+
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+}
+
+// Get deals in a stage
+//
+// Lists deals in a specific stage.
+//
+// https://developers.pipedrive.com/docs/api/v1/Stages#getStageDeals
+func (p *Pipedrive) ListStageDeals(id int, f StageDealsFilter) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("stages/%d/deals", id)
+	url := p.makeApiEndpoint(ep)
+
+	if f.FilterId > 0 {
+		url.Query.Add("filter_id", strconv.Itoa(f.FilterId))
+	}
+
+	if f.UserId > 0 {
+		url.Query.Add("user_id", strconv.Itoa(f.UserId))
+	}
+
+	if f.Everyone == true {
+		url.Query.Add("everyone", "1")
+	}
+
+	if f.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(f.Start))
+	}
+
+	if f.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(f.Limit))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
@@ -0,0 +1,351 @@
+package pipedrive
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// How often a recurring subscription is billed
+type SubscriptionCadence string
+
+const (
+	SubscriptionCadenceWeekly    SubscriptionCadence = "weekly"
+	SubscriptionCadenceMonthly   SubscriptionCadence = "monthly"
+	SubscriptionCadenceQuarterly SubscriptionCadence = "quarterly"
+	SubscriptionCadenceYearly    SubscriptionCadence = "yearly"
+)
+
+type SubscriptionPaymentType string
+
+const (
+	SubscriptionPaymentRecurring   SubscriptionPaymentType = "recurring"
+	SubscriptionPaymentAdditional  SubscriptionPaymentType = "additional"
+	SubscriptionPaymentInstallment SubscriptionPaymentType = "installment"
+)
+
+// How a payment changes the revenue
+type RevenueMovementType string
+
+const (
+	RevenueMovementNew         RevenueMovementType = "new"
+	RevenueMovementRecurring   RevenueMovementType = "recurring"
+	RevenueMovementExpansion   RevenueMovementType = "expansion"
+	RevenueMovementContraction RevenueMovementType = "contraction"
+	RevenueMovementNone        RevenueMovementType = "none"
+	RevenueMovementChurn       RevenueMovementType = "churn"
+)
+
+type Subscription struct {
+	Id            int                 `json:"id"`
+	UserId        int                 `json:"user_id"`
+	DealId        int                 `json:"deal_id"`
+	Description   string              `json:"description"`
+	IsActive      bool                `json:"is_active"`
+	CyclesCount   int                 `json:"cycles_count"`
+	CycleAmount   float64             `json:"cycle_amount"`
+	Infinite      bool                `json:"infinite"`
+	Currency      string              `json:"currency"`
+	CadenceType   SubscriptionCadence `json:"cadence_type"`
+	StartDate     string              `json:"start_date"`
+	EndDate       string              `json:"end_date"`
+	LifetimeValue float64             `json:"lifetime_value"`
+	FinalStatus   string              `json:"final_status"`
+	AddTime       string              `json:"add_time"`
+	UpdateTime    string              `json:"update_time"`
+}
+
+// Scheduled payment of a subscription
+type SubscriptionPayment struct {
+	Id                  int                     `json:"id"`
+	SubscriptionId      int                     `json:"subscription_id"`
+	DealId              int                     `json:"deal_id"`
+	IsActive            bool                    `json:"is_active"`
+	Amount              float64                 `json:"amount"`
+	Currency            string                  `json:"currency"`
+	ChangeAmount        float64                 `json:"change_amount"`
+	DueAt               string                  `json:"due_at"`
+	RevenueMovementType RevenueMovementType     `json:"revenue_movement_type"`
+	PaymentType         SubscriptionPaymentType `json:"payment_type"`
+	Description         string                  `json:"description"`
+	AddTime             string                  `json:"add_time"`
+	UpdateTime          string                  `json:"update_time"`
+}
+
+// Payment to schedule
+type SubscriptionPaymentParams struct {
+	Amount      float64 `json:"amount"`
+	Description string  `json:"description,omitempty"`
+
+	// Format: YYYY-MM-DD
+	DueAt string `json:"due_at"`
+}
+
+// Get details of a subscription
+//
+// https://developers.pipedrive.com/docs/api/v1/Subscriptions#getSubscription
+func (p *Pipedrive) GetSubscription(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("subscriptions/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Find subscription by deal
+//
+// Returns details of an installment or a recurring subscription by the deal ID.
+//
+// https://developers.pipedrive.com/docs/api/v1/Subscriptions#getSubscriptionByDeal
+func (p *Pipedrive) FindDealSubscription(dealId int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("subscriptions/find/%d", dealId)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get all payments of a subscription
+//
+// https://developers.pipedrive.com/docs/api/v1/Subscriptions#getSubscriptionPayments
+func (p *Pipedrive) ListSubscriptionPayments(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("subscriptions/%d/payments", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Payment schedule of a subscription
+//
+// Same as ListSubscriptionPayments, but returns the typed payments.
+func (p *Pipedrive) GetSubscriptionSchedule(id int) ([]SubscriptionPayment, error) {
+	pd_resp, err := p.ListSubscriptionPayments(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var payments []SubscriptionPayment
+
+	// Subscription without payments returns no data
+	if pd_resp.Status >= 400 || pd_resp.Data != nil {
+		err = pd_resp.DecodeData(&payments)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return payments, nil
+}
+
+// Recurring subscription to add
+type RecurringSubscriptionParams struct {
+	DealId      int                 `json:"deal_id"`
+	Currency    string              `json:"currency"`
+	Description string              `json:"description,omitempty"`
+	CadenceType SubscriptionCadence `json:"cadence_type"`
+
+	// Number of payments, ignored for infinite subscriptions
+	CyclesCount int     `json:"cycles_count,omitempty"`
+	CycleAmount float64 `json:"cycle_amount"`
+
+	// Format: YYYY-MM-DD
+	StartDate string `json:"start_date"`
+	Infinite  bool   `json:"infinite,omitempty"`
+
+	// Additional payments
+	Payments []SubscriptionPaymentParams `json:"payments,omitempty"`
+
+	// Set the deal value to the subscription lifetime value
+	UpdateDealValue bool `json:"update_deal_value,omitempty"`
+}
+
+// Add a recurring subscription
+//
+// https://developers.pipedrive.com/docs/api/v1/Subscriptions#addRecurringSubscription
+func (p *Pipedrive) AddRecurringSubscription(params RecurringSubscriptionParams) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("subscriptions/recurring")
+
+	if params.DealId <= 0 || params.Currency == "" {
+		return nil, errors.New("Deal id and currency are required")
+	}
+
+	if params.CadenceType == "" || params.StartDate == "" {
+		return nil, errors.New("Cadence type and start date are required")
+	}
+
+	if !params.Infinite && params.CyclesCount <= 0 {
+		return nil, errors.New("Cycles count is required for a finite subscription")
+	}
+
+	return p.sendSubscription("POST", url, params)
+}
+
+// Installment subscription to add
+type InstallmentSubscriptionParams struct {
+	DealId   int                         `json:"deal_id"`
+	Currency string                      `json:"currency"`
+	Payments []SubscriptionPaymentParams `json:"payments"`
+
+	// Set the deal value to the sum of the installments
+	UpdateDealValue bool `json:"update_deal_value,omitempty"`
+}
+
+// Add an installment subscription
+//
+// https://developers.pipedrive.com/docs/api/v1/Subscriptions#addSubscriptionInstallment
+func (p *Pipedrive) AddInstallmentSubscription(params InstallmentSubscriptionParams) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("subscriptions/installment")
+
+	if params.DealId <= 0 || params.Currency == "" {
+		return nil, errors.New("Deal id and currency are required")
+	}
+
+	if len(params.Payments) < 1 {
+		return nil, errors.New("At least one payment is required")
+	}
+
+	return p.sendSubscription("POST", url, params)
+}
+
+// Recurring subscription changes. Empty values are left untouched.
+type RecurringSubscriptionUpdate struct {
+	Description string                      `json:"description,omitempty"`
+	CycleAmount float64                     `json:"cycle_amount,omitempty"`
+	Payments    []SubscriptionPaymentParams `json:"payments,omitempty"`
+
+	// Date the changes take effect from. Format: YYYY-MM-DD
+	EffectiveDate string `json:"effective_date"`
+
+	UpdateDealValue bool `json:"update_deal_value,omitempty"`
+}
+
+// Update a recurring subscription
+//
+// https://developers.pipedrive.com/docs/api/v1/Subscriptions#updateRecurringSubscription
+func (p *Pipedrive) UpdateRecurringSubscription(id int, params RecurringSubscriptionUpdate) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("subscriptions/recurring/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	if params.EffectiveDate == "" {
+		return nil, errors.New("Effective date is required")
+	}
+
+	return p.sendSubscription("PUT", url, params)
+}
+
+// Update an installment subscription
+//
+// Replaces the payments of the subscription.
+//
+// https://developers.pipedrive.com/docs/api/v1/Subscriptions#updateSubscriptionInstallment
+func (p *Pipedrive) UpdateInstallmentSubscription(id int, payments []SubscriptionPaymentParams, updateDealValue bool) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("subscriptions/installment/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	if len(payments) < 1 {
+		return nil, errors.New("At least one payment is required")
+	}
+
+	body := map[string]interface{}{
+		"payments":          payments,
+		"update_deal_value": updateDealValue,
+	}
+
+	return p.sendSubscription("PUT", url, body)
+}
+
+// Cancel a recurring subscription
+//
+// If endDate is empty the subscription is cancelled immediately.
+// Format: YYYY-MM-DD
+//
+// https://developers.pipedrive.com/docs/api/v1/Subscriptions#cancelRecurringSubscription
+func (p *Pipedrive) CancelRecurringSubscription(id int, endDate string) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("subscriptions/recurring/%d/cancel", id)
+	url := p.makeApiEndpoint(ep)
+
+	body := map[string]interface{}{}
+
+	if endDate != "" {
+		body["end_date"] = endDate
+	}
+
+	return p.sendSubscription("PUT", url, body)
+}
+
+// Delete a subscription
+//
+// Marks an installment or a recurring subscription as deleted.
+//
+// https://developers.pipedrive.com/docs/api/v1/Subscriptions#deleteSubscription
+func (p *Pipedrive) DeleteSubscription(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("subscriptions/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+func (p *Pipedrive) sendSubscription(method string, url *PdEndpoint, body interface{}) (*PipedriveResponse, error) {
+	json_data, err := json.Marshal(body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	req, err := http.NewRequest(method, url.String(), buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
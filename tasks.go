@@ -0,0 +1,172 @@
+package pipedrive
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Task of a project
+type Task struct {
+	Id               int    `json:"id"`
+	Title            string `json:"title"`
+	ProjectId        int    `json:"project_id"`
+	Description      string `json:"description"`
+	ParentTaskId     int    `json:"parent_task_id"`
+	AssigneeId       int    `json:"assignee_id"`
+	Done             Flag   `json:"done"`
+	DueDate          string `json:"due_date"`
+	CreatorId        int    `json:"creator_id"`
+	AddTime          string `json:"add_time"`
+	UpdateTime       string `json:"update_time"`
+	MarkedAsDoneTime string `json:"marked_as_done_time"`
+}
+
+// Task details to add or update. Empty values are not sent.
+type TaskParams struct {
+	Title        string `json:"title,omitempty"`
+	ProjectId    int    `json:"project_id,omitempty"`
+	Description  string `json:"description,omitempty"`
+	ParentTaskId int    `json:"parent_task_id,omitempty"`
+	AssigneeId   int    `json:"assignee_id,omitempty"`
+	Done         *Flag  `json:"done,omitempty"`
+
+	// Format: YYYY-MM-DD
+	DueDate string `json:"due_date,omitempty"`
+}
+
+type TasksFilter struct {
+	// Pagination cursor, see PipedriveResponse.NextCursor
+	Cursor string
+
+	// Items shown per page
+	Limit int
+
+	Assignee int
+	Project  int
+
+	// Only subtasks of the task are returned
+	ParentTask int
+
+	// Only done (or not done) tasks are returned
+	Done *bool
+}
+
+// Get all tasks
+//
+// Returns all tasks. This is a cursor-paginated endpoint.
+//
+// https://developers.pipedrive.com/docs/api/v1/Tasks#getTasks
+func (p *Pipedrive) ListTasks(f TasksFilter) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("tasks")
+
+	if f.Cursor != "" {
+		url.Query.Add("cursor", f.Cursor)
+	}
+
+	if f.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(f.Limit))
+	}
+
+	if f.Assignee > 0 {
+		url.Query.Add("assignee_id", strconv.Itoa(f.Assignee))
+	}
+
+	if f.Project > 0 {
+		url.Query.Add("project_id", strconv.Itoa(f.Project))
+	}
+
+	if f.ParentTask > 0 {
+		url.Query.Add("parent_task_id", strconv.Itoa(f.ParentTask))
+	}
+
+	if f.Done != nil {
+		url.Query.Add("done", flagValue(*f.Done))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get details of a task
+//
+// https://developers.pipedrive.com/docs/api/v1/Tasks#getTask
+func (p *Pipedrive) GetTask(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("tasks/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Add a task
+//
+// Adds a new task. Title and ProjectId are required.
+//
+// https://developers.pipedrive.com/docs/api/v1/Tasks#addTask
+func (p *Pipedrive) AddTask(params TaskParams) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("tasks")
+
+	if params.Title == "" {
+		return nil, errors.New("Task title is required")
+	}
+
+	if params.ProjectId <= 0 {
+		return nil, errors.New("Project id is required")
+	}
+
+	return p.sendJson("POST", url, params)
+}
+
+// Update a task
+//
+// Updates a task. Empty values are left untouched.
+//
+// https://developers.pipedrive.com/docs/api/v1/Tasks#updateTask
+func (p *Pipedrive) UpdateTask(id int, params TaskParams) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("tasks/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	return p.sendJson("PUT", url, params)
+}
+
+// Delete a task
+//
+// Marks a task as deleted. If the task has subtasks then those will
+// also be deleted.
+//
+// https://developers.pipedrive.com/docs/api/v1/Tasks#deleteTask
+func (p *Pipedrive) DeleteTask(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("tasks/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
@@ -1,6 +1,13 @@
 package pipedrive
 
-import "net/http"
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+)
 
 func (p *Pipedrive) ListUsers() (*PipedriveResponse, error) {
 	url := p.makeApiEndpoint("users")
@@ -13,3 +20,324 @@ func (p *Pipedrive) ListUsers() (*PipedriveResponse, error) {
 	pd_resp := p.readResponse(resp)
 	return pd_resp, nil
 }
+
+// Reference to a user.
+//
+// Depending on the endpoint Pipedrive returns users either as a plain id or
+// as an object with user details. Both forms are decoded into UserRef, when
+// encoded only the id is sent.
+type UserRef struct {
+	Id    int    `json:"id"`
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+func (u *UserRef) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '{' {
+		type plain UserRef
+		return json.Unmarshal(data, (*plain)(u))
+	}
+
+	return json.Unmarshal(data, &u.Id)
+}
+
+func (u UserRef) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.Id)
+}
+
+// Apps user can have access to
+type UserApp string
+
+const (
+	UserAppSales           UserApp = "sales"
+	UserAppProjects        UserApp = "projects"
+	UserAppCampaigns       UserApp = "campaigns"
+	UserAppGlobal          UserApp = "global"
+	UserAppAccountSettings UserApp = "account_settings"
+)
+
+// Access of a user to an app
+type UserAccess struct {
+	App             UserApp `json:"app"`
+	Admin           bool    `json:"admin"`
+	PermissionSetId string  `json:"permission_set_id,omitempty"`
+}
+
+type User struct {
+	Id                int          `json:"id"`
+	Name              string       `json:"name"`
+	Email             string       `json:"email"`
+	Phone             string       `json:"phone"`
+	DefaultCurrency   string       `json:"default_currency"`
+	Locale            string       `json:"locale"`
+	Lang              int          `json:"lang"`
+	Activated         bool         `json:"activated"`
+	ActiveFlag        bool         `json:"active_flag"`
+	IsAdmin           int          `json:"is_admin"`
+	IsYou             bool         `json:"is_you"`
+	IsDeleted         bool         `json:"is_deleted"`
+	RoleId            int          `json:"role_id"`
+	IconUrl           string       `json:"icon_url"`
+	TimezoneName      string       `json:"timezone_name"`
+	TimezoneOffset    string       `json:"timezone_offset"`
+	HasCreatedCompany bool         `json:"has_created_company"`
+	LastLogin         string       `json:"last_login"`
+	Created           string       `json:"created"`
+	Modified          string       `json:"modified"`
+	Access            []UserAccess `json:"access"`
+}
+
+// User language
+type UserLanguage struct {
+	LanguageCode string `json:"language_code"`
+	CountryCode  string `json:"country_code"`
+}
+
+// Authorized user along with the company details
+type CurrentUser struct {
+	User
+
+	CompanyId       int          `json:"company_id"`
+	CompanyName     string       `json:"company_name"`
+	CompanyDomain   string       `json:"company_domain"`
+	CompanyCountry  string       `json:"company_country"`
+	CompanyIndustry string       `json:"company_industry"`
+	Language        UserLanguage `json:"language"`
+}
+
+// Get current user data
+//
+// Returns data about an authorized user within the company with bound company
+// data: company ID, company name, and domain. Note that the locale property
+// means 'Date/number format' in the Pipedrive account settings, not the chosen
+// language.
+//
+// https://developers.pipedrive.com/docs/api/v1/Users#getCurrentUser
+func (p *Pipedrive) GetCurrentUser() (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("users/me")
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Find users by name
+//
+// Finds users by their name. If byEmail is set, the term is matched against
+// the email addresses of the users instead.
+//
+// https://developers.pipedrive.com/docs/api/v1/Users#findUsersByName
+func (p *Pipedrive) FindUsers(term string, byEmail bool) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("users/find")
+
+	if term == "" {
+		return nil, errors.New("Search term cannot be empty")
+	}
+
+	url.Query.Add("term", term)
+
+	if byEmail == true {
+		url.Query.Add("search_by_email", "1")
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Get one user
+//
+// https://developers.pipedrive.com/docs/api/v1/Users#getUser
+func (p *Pipedrive) GetUser(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("users/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Add a new user
+//
+// Adds a new user to the company, returns the ID upon success. If access is
+// empty the user gets regular access to the sales app.
+//
+// https://developers.pipedrive.com/docs/api/v1/Users#addUser
+func (p *Pipedrive) AddUser(email string, access []UserAccess, active bool) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("users")
+
+	if email == "" {
+		return nil, errors.New("User email is required")
+	}
+
+	body := map[string]interface{}{
+		"email":       email,
+		"active_flag": active,
+	}
+
+	if len(access) > 0 {
+		body["access"] = access
+	}
+
+	json_data, err := json.Marshal(body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Update user details
+//
+// Updates the properties of a user. Currently, only active_flag can be updated.
+//
+// https://developers.pipedrive.com/docs/api/v1/Users#updateUser
+func (p *Pipedrive) UpdateUser(id int, active bool) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("users/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	json_data, err := json.Marshal(map[string]interface{}{"active_flag": active})
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	req, err := http.NewRequest("PUT", url.String(), buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// List followers of a user
+//
+// https://developers.pipedrive.com/docs/api/v1/Users#getUserFollowers
+func (p *Pipedrive) ListUserFollowers(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("users/%d/followers", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// List user permissions
+//
+// Lists aggregated permissions over all assigned permission sets for a user.
+//
+// https://developers.pipedrive.com/docs/api/v1/Users#getUserPermissions
+func (p *Pipedrive) ListUserPermissions(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("users/%d/permissions", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Pagination options
+type UserRoleAssignmentsFilter struct {
+	// Pagination start
+	//
+	// Default - 0
+	Start int
+
+	// Items shown per page
+	Limit int
+}
+
+// List role assignments
+//
+// Lists role assignments for a user.
+//
+// https://developers.pipedrive.com/docs/api/v1/Users#getUserRoleAssignments
+func (p *Pipedrive) ListUserRoleAssignments(id int, f UserRoleAssignmentsFilter) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("users/%d/roleAssignments", id)
+	url := p.makeApiEndpoint(ep)
+
+	if f.Start >= 0 {
+		url.Query.Add("start", strconv.Itoa(f.Start))
+	}
+
+	if f.Limit > 0 {
+		url.Query.Add("limit", strconv.Itoa(f.Limit))
+	}
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// List user role settings
+//
+// Lists the settings of user's assigned role.
+//
+// https://developers.pipedrive.com/docs/api/v1/Users#getUserRoleSettings
+func (p *Pipedrive) ListUserRoleSettings(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("users/%d/roleSettings", id)
+	url := p.makeApiEndpoint(ep)
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
@@ -0,0 +1,23 @@
+package pipedrive
+
+import (
+	"net/http"
+)
+
+// Get all user connections
+//
+// Returns data about all connections for the authorized user.
+//
+// https://developers.pipedrive.com/docs/api/v1/UserConnections#getUserConnections
+func (p *Pipedrive) GetUserConnections() (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("userConnections")
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
@@ -0,0 +1,21 @@
+package pipedrive
+
+import (
+	"net/http"
+)
+
+// List settings of an authorized user
+//
+// https://developers.pipedrive.com/docs/api/v1/UserSettings#getUserSettings
+func (p *Pipedrive) GetUserSettings() (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("userSettings")
+
+	resp, err := http.Get(url.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
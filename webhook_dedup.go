@@ -0,0 +1,231 @@
+package pipedrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Storage of processed webhook event ids
+type WebhookEventStore interface {
+	// Marks the event key as processed for ttl. Returns false if the key
+	// is already marked.
+	Reserve(ctx context.Context, key string, ttl time.Duration) (bool, error)
+
+	// Removes the mark, so the event can be processed again
+	Release(ctx context.Context, key string) error
+}
+
+// Storage of the last processed version of every entity
+type WebhookVersionStore interface {
+	// Returns the last processed version of the entity key.
+	// Zero time is returned for unknown keys.
+	LastVersion(ctx context.Context, key string) (time.Time, error)
+
+	// Stores version of the entity key unless a newer one is already stored
+	SetVersion(ctx context.Context, key string, version time.Time) error
+}
+
+// In-memory WebhookEventStore
+//
+// Suitable for a single process only, use your own store (e.g. redis SETNX)
+// when webhooks are received by several instances.
+type MemoryWebhookEventStore struct {
+	mu         sync.Mutex
+	keys       map[string]time.Time
+	next_sweep time.Time
+}
+
+func NewMemoryWebhookEventStore() *MemoryWebhookEventStore {
+	return &MemoryWebhookEventStore{keys: map[string]time.Time{}}
+}
+
+func (s *MemoryWebhookEventStore) Reserve(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+
+	if now.After(s.next_sweep) {
+		for k, expire := range s.keys {
+			if now.After(expire) {
+				delete(s.keys, k)
+			}
+		}
+		s.next_sweep = now.Add(time.Minute)
+	}
+
+	expire, ok := s.keys[key]
+	if ok && now.Before(expire) {
+		return false, nil
+	}
+
+	s.keys[key] = now.Add(ttl)
+	return true, nil
+}
+
+func (s *MemoryWebhookEventStore) Release(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.keys, key)
+	return nil
+}
+
+type memoryVersion struct {
+	version time.Time
+	expire  time.Time
+}
+
+// In-memory WebhookVersionStore
+//
+// Versions are forgotten after ttl since the last update, so the store
+// does not grow with every entity ever changed.
+type MemoryWebhookVersionStore struct {
+	ttl        time.Duration
+	mu         sync.Mutex
+	versions   map[string]memoryVersion
+	next_sweep time.Time
+}
+
+func NewMemoryWebhookVersionStore(ttl time.Duration) *MemoryWebhookVersionStore {
+	return &MemoryWebhookVersionStore{ttl: ttl, versions: map[string]memoryVersion{}}
+}
+
+func (s *MemoryWebhookVersionStore) LastVersion(ctx context.Context, key string) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.versions[key]
+	if !ok || time.Now().After(v.expire) {
+		return time.Time{}, nil
+	}
+
+	return v.version, nil
+}
+
+func (s *MemoryWebhookVersionStore) SetVersion(ctx context.Context, key string, version time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+
+	if now.After(s.next_sweep) {
+		for k, v := range s.versions {
+			if now.After(v.expire) {
+				delete(s.versions, k)
+			}
+		}
+		s.next_sweep = now.Add(time.Minute)
+	}
+
+	v, ok := s.versions[key]
+	if ok && now.Before(v.expire) && v.version.After(version) {
+		return nil
+	}
+
+	s.versions[key] = memoryVersion{version: version, expire: now.Add(s.ttl)}
+	return nil
+}
+
+// Error of the bookkeeping done after the event is processed. The event
+// itself was handled, so it must not be processed again.
+type webhookTrackingError struct {
+	err error
+}
+
+func (e *webhookTrackingError) Error() string {
+	return e.err.Error()
+}
+
+func (e *webhookTrackingError) Unwrap() error {
+	return e.err
+}
+
+// Middleware skipping already processed deliveries
+//
+// Events are identified by the meta id (webhooks v2) or by the object, id
+// and timestamp (webhooks v1). Retries of a processed event are acknowledged
+// without calling the handlers. If processing fails the mark is released,
+// so the retry is processed again. If the release fails too, both errors are
+// returned and the retry is skipped until the mark expires. Failures of
+// OrderWebhooks to store the version after the event is processed keep the
+// mark, as the event was handled.
+//
+//	h.Use(pipedrive.DeduplicateWebhooks(pipedrive.NewMemoryWebhookEventStore(), 24*time.Hour))
+func DeduplicateWebhooks(store WebhookEventStore, ttl time.Duration) WebhookMiddleware {
+	return func(next WebhookFunc) WebhookFunc {
+		return func(ctx context.Context, e *WebhookEvent) error {
+			key := e.Meta.EventId
+			if key == "" {
+				return next(ctx, e)
+			}
+
+			reserved, err := store.Reserve(ctx, key, ttl)
+			if err != nil {
+				return err
+			}
+
+			if !reserved {
+				return nil
+			}
+
+			err = next(ctx, e)
+
+			var tracking_err *webhookTrackingError
+			if err != nil && !errors.As(err, &tracking_err) {
+				rel_err := store.Release(ctx, key)
+				if rel_err != nil {
+					return fmt.Errorf("%w (release of event %s failed: %v)", err, key, rel_err)
+				}
+			}
+
+			return err
+		}
+	}
+}
+
+// Middleware dropping out of order deliveries
+//
+// Events older than the last processed event of the same entity are
+// acknowledged without calling the handlers. Events with the same
+// timestamp are processed.
+//
+// The check is best-effort: the last version is read before the handlers
+// are called and stored after they succeed, not atomically. Deliveries of
+// the same entity processed concurrently are all handled and may finish in
+// any order, the store keeps the newest version only.
+func OrderWebhooks(store WebhookVersionStore) WebhookMiddleware {
+	return func(next WebhookFunc) WebhookFunc {
+		return func(ctx context.Context, e *WebhookEvent) error {
+			if e.Meta.EntityId == "" || e.Meta.Timestamp.IsZero() {
+				return next(ctx, e)
+			}
+
+			key := fmt.Sprintf("%s:%s", e.Meta.Object, e.Meta.EntityId)
+
+			last, err := store.LastVersion(ctx, key)
+			if err != nil {
+				return err
+			}
+
+			if e.Meta.Timestamp.Before(last) {
+				return nil
+			}
+
+			err = next(ctx, e)
+			if err != nil {
+				return err
+			}
+
+			err = store.SetVersion(ctx, key, e.Meta.Timestamp)
+			if err != nil {
+				return &webhookTrackingError{err: err}
+			}
+
+			return nil
+		}
+	}
+}
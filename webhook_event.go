@@ -0,0 +1,385 @@
+package pipedrive
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Kind of a webhook event regardless of the webhook version
+type WebhookEventKind string
+
+const (
+	WebhookEventCreated WebhookEventKind = "created"
+	WebhookEventUpdated WebhookEventKind = "updated"
+	WebhookEventDeleted WebhookEventKind = "deleted"
+	WebhookEventMerged  WebhookEventKind = "merged"
+)
+
+// Returns event kind of the action
+func (a WebhookAction) Kind() WebhookEventKind {
+	switch a {
+	case WebhookActionAdded, WebhookActionCreate:
+		return WebhookEventCreated
+	case WebhookActionUpdated, WebhookActionChange:
+		return WebhookEventUpdated
+	case WebhookActionDeleted, WebhookActionDelete:
+		return WebhookEventDeleted
+	case WebhookActionMerged:
+		return WebhookEventMerged
+	}
+
+	return WebhookEventKind(a)
+}
+
+// Metadata of a webhook event normalized across webhook versions
+type WebhookMeta struct {
+	Version WebhookVersion
+
+	// Unique id of the event. Webhooks v1 do not have one, so it is made up
+	// of the object, its id and the timestamp of the change.
+	EventId string
+
+	Action WebhookAction
+	Object WebhookObject
+
+	// Id of the changed entity. Leads have UUIDs, so it is kept as a string.
+	EntityId string
+
+	CompanyId        int
+	UserId           int
+	WebhookId        string
+	Host             string
+	ChangeSource     string
+	IsBulkUpdate     bool
+	PermittedUserIds []int
+
+	// Time of the change
+	Timestamp time.Time
+
+	// Delivery attempt, starting from 1
+	Attempt int
+}
+
+// Decoded webhook delivery
+type WebhookEvent struct {
+	Meta WebhookMeta
+
+	// Entity after the change. Empty for deleted entities.
+	Current json.RawMessage
+
+	// Entity before the change. Empty for created entities.
+	//
+	// Webhooks v2 send only the changed fields as previous, they are merged
+	// with the current entity, so Previous always holds the whole entity.
+	Previous json.RawMessage
+}
+
+// Returns event kind
+func (e WebhookEvent) Kind() WebhookEventKind {
+	return e.Meta.Action.Kind()
+}
+
+// Decodes current entity into v
+func (e WebhookEvent) DecodeCurrent(v interface{}) error {
+	if len(e.Current) == 0 {
+		return errors.New("No current data in the event")
+	}
+
+	return json.Unmarshal(e.Current, v)
+}
+
+// Decodes previous entity into v
+func (e WebhookEvent) DecodePrevious(v interface{}) error {
+	if len(e.Previous) == 0 {
+		return errors.New("No previous data in the event")
+	}
+
+	return json.Unmarshal(e.Previous, v)
+}
+
+// Single changed field
+type FieldChange struct {
+	// Name of the field. Nested fields (like custom fields of webhooks v2)
+	// are joined with dot: custom_fields.<hash>
+	Field    string
+	Previous interface{}
+	Current  interface{}
+}
+
+// Returns the fields which differ between previous and current entity,
+// ordered by the field name.
+func (e WebhookEvent) Changes() ([]FieldChange, error) {
+	prev := map[string]interface{}{}
+	cur := map[string]interface{}{}
+
+	if len(e.Previous) > 0 {
+		err := json.Unmarshal(e.Previous, &prev)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(e.Current) > 0 {
+		err := json.Unmarshal(e.Current, &cur)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return DiffFields(prev, cur), nil
+}
+
+// Returns names of the fields which differ between previous and current entity
+func (e WebhookEvent) ChangedFields() ([]string, error) {
+	changes, err := e.Changes()
+
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]string, len(changes))
+	for idx, change := range changes {
+		fields[idx] = change.Field
+	}
+
+	return fields, nil
+}
+
+// Returns the fields which differ between two versions of an entity,
+// ordered by the field name. Nested objects are compared field by field.
+func DiffFields(prev map[string]interface{}, cur map[string]interface{}) []FieldChange {
+	changes := []FieldChange{}
+	diffFields("", prev, cur, &changes)
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+
+	return changes
+}
+
+func diffFields(prefix string, prev map[string]interface{}, cur map[string]interface{}, changes *[]FieldChange) {
+	keys := map[string]bool{}
+	for k := range prev {
+		keys[k] = true
+	}
+	for k := range cur {
+		keys[k] = true
+	}
+
+	for k := range keys {
+		pv, cv := prev[k], cur[k]
+		if reflect.DeepEqual(pv, cv) {
+			continue
+		}
+
+		pm, p_ok := pv.(map[string]interface{})
+		cm, c_ok := cv.(map[string]interface{})
+		if p_ok && c_ok {
+			diffFields(prefix+k+".", pm, cm, changes)
+			continue
+		}
+
+		*changes = append(*changes, FieldChange{Field: prefix + k, Previous: pv, Current: cv})
+	}
+}
+
+// Deep merges patch into base, returns a new map
+func mergeFields(base map[string]interface{}, patch map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(base))
+	for k, v := range base {
+		result[k] = v
+	}
+
+	for k, v := range patch {
+		bm, b_ok := result[k].(map[string]interface{})
+		pm, p_ok := v.(map[string]interface{})
+		if b_ok && p_ok {
+			result[k] = mergeFields(bm, pm)
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+type webhookPayloadV1 struct {
+	Meta struct {
+		Action           WebhookAction `json:"action"`
+		Object           WebhookObject `json:"object"`
+		Id               json.Number   `json:"id"`
+		CompanyId        int           `json:"company_id"`
+		UserId           int           `json:"user_id"`
+		WebhookId        json.Number   `json:"webhook_id"`
+		Host             string        `json:"host"`
+		ChangeSource     string        `json:"change_source"`
+		IsBulkUpdate     bool          `json:"is_bulk_update"`
+		PermittedUserIds []int         `json:"permitted_user_ids"`
+		Timestamp        int64         `json:"timestamp"`
+		TimestampMicro   int64         `json:"timestamp_micro"`
+	} `json:"meta"`
+	Current  json.RawMessage `json:"current"`
+	Previous json.RawMessage `json:"previous"`
+	Retry    int             `json:"retry"`
+}
+
+type webhookPayloadV2 struct {
+	Meta struct {
+		Version          WebhookVersion `json:"version"`
+		Id               string         `json:"id"`
+		Action           WebhookAction  `json:"action"`
+		Entity           WebhookObject  `json:"entity"`
+		EntityId         string         `json:"entity_id"`
+		CompanyId        string         `json:"company_id"`
+		UserId           string         `json:"user_id"`
+		WebhookId        string         `json:"webhook_id"`
+		Host             string         `json:"host"`
+		ChangeSource     string         `json:"change_source"`
+		IsBulkEdit       bool           `json:"is_bulk_edit"`
+		PermittedUserIds []string       `json:"permitted_user_ids"`
+		Timestamp        time.Time      `json:"timestamp"`
+		Attempt          int            `json:"attempt"`
+	} `json:"meta"`
+	Data     json.RawMessage `json:"data"`
+	Previous json.RawMessage `json:"previous"`
+}
+
+// Decodes webhook delivery body.
+//
+// Both webhooks v1 and v2 payloads are supported.
+func ParseWebhookEvent(body []byte) (*WebhookEvent, error) {
+	var probe struct {
+		Meta struct {
+			Version string `json:"version"`
+		} `json:"meta"`
+	}
+
+	err := json.Unmarshal(body, &probe)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if probe.Meta.Version == string(WebhookVersion2) {
+		return parseWebhookEventV2(body)
+	}
+
+	return parseWebhookEventV1(body)
+}
+
+func parseWebhookEventV1(body []byte) (*WebhookEvent, error) {
+	var payload webhookPayloadV1
+	err := json.Unmarshal(body, &payload)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m := payload.Meta
+	meta := WebhookMeta{
+		Version:          WebhookVersion1,
+		Action:           m.Action,
+		Object:           m.Object,
+		EntityId:         m.Id.String(),
+		CompanyId:        m.CompanyId,
+		UserId:           m.UserId,
+		WebhookId:        m.WebhookId.String(),
+		Host:             m.Host,
+		ChangeSource:     m.ChangeSource,
+		IsBulkUpdate:     m.IsBulkUpdate,
+		PermittedUserIds: m.PermittedUserIds,
+		Attempt:          payload.Retry + 1,
+	}
+
+	if m.TimestampMicro > 0 {
+		meta.Timestamp = time.UnixMicro(m.TimestampMicro).UTC()
+	} else {
+		meta.Timestamp = time.Unix(m.Timestamp, 0).UTC()
+	}
+
+	meta.EventId = fmt.Sprintf("%s:%s:%s:%d", m.Object, m.Action, meta.EntityId, meta.Timestamp.UnixMicro())
+
+	return &WebhookEvent{
+		Meta:     meta,
+		Current:  nullToEmpty(payload.Current),
+		Previous: nullToEmpty(payload.Previous),
+	}, nil
+}
+
+func parseWebhookEventV2(body []byte) (*WebhookEvent, error) {
+	var payload webhookPayloadV2
+	err := json.Unmarshal(body, &payload)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m := payload.Meta
+	meta := WebhookMeta{
+		Version:      m.Version,
+		EventId:      m.Id,
+		Action:       m.Action,
+		Object:       m.Entity,
+		EntityId:     m.EntityId,
+		WebhookId:    m.WebhookId,
+		Host:         m.Host,
+		ChangeSource: m.ChangeSource,
+		IsBulkUpdate: m.IsBulkEdit,
+		Timestamp:    m.Timestamp.UTC(),
+		Attempt:      m.Attempt,
+	}
+
+	meta.CompanyId, _ = strconv.Atoi(m.CompanyId)
+	meta.UserId, _ = strconv.Atoi(m.UserId)
+
+	for _, id := range m.PermittedUserIds {
+		val, err := strconv.Atoi(id)
+		if err == nil {
+			meta.PermittedUserIds = append(meta.PermittedUserIds, val)
+		}
+	}
+
+	event := &WebhookEvent{
+		Meta:     meta,
+		Current:  nullToEmpty(payload.Data),
+		Previous: nullToEmpty(payload.Previous),
+	}
+
+	// Previous holds only the changed fields, restore the whole entity
+	if len(event.Current) > 0 && len(event.Previous) > 0 {
+		cur := map[string]interface{}{}
+		prev := map[string]interface{}{}
+
+		err = json.Unmarshal(event.Current, &cur)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(event.Previous, &prev)
+		if err != nil {
+			return nil, err
+		}
+
+		event.Previous, err = json.Marshal(mergeFields(cur, prev))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return event, nil
+}
+
+func nullToEmpty(raw json.RawMessage) json.RawMessage {
+	if string(raw) == "null" {
+		return nil
+	}
+
+	return raw
+}
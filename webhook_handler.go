@@ -0,0 +1,343 @@
+package pipedrive
+
+import (
+	"context"
+	"crypto/subtle"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// Max size of a webhook delivery body
+const webhookMaxBodySize = 10 << 20
+
+// Function processing a webhook event.
+//
+// Returning an error makes the handler respond with 500, so Pipedrive
+// retries the delivery. The error is not sent in the response, log it in
+// the function or in a middleware.
+type WebhookFunc func(ctx context.Context, e *WebhookEvent) error
+
+// Function wrapping event processing, see WebhookHandler.Use
+type WebhookMiddleware func(next WebhookFunc) WebhookFunc
+
+type webhookRoute struct {
+	object WebhookObject
+	kind   WebhookEventKind
+	fn     WebhookFunc
+}
+
+// HTTP handler receiving Pipedrive webhooks
+//
+// Verifies basic auth credentials, decodes v1 and v2 payloads and
+// dispatches them to the handlers registered for the event object and kind.
+//
+//	h := pipedrive.NewWebhookHandler("user", "secret")
+//	h.OnDealUpdated(func(ctx context.Context, prev, cur pipedrive.Deal) error {
+//		...
+//	})
+//	http.Handle("/pipedrive", h)
+type WebhookHandler struct {
+	user     string
+	password string
+
+	mu         sync.RWMutex
+	routes     []webhookRoute
+	middleware []WebhookMiddleware
+}
+
+// Creates webhook handler.
+//
+// Deliveries must carry the given basic auth credentials, which are set
+// with HttpAuthUser and HttpAuthPassword of the webhook. If user is empty
+// the credentials are not checked.
+func NewWebhookHandler(user string, password string) *WebhookHandler {
+	return &WebhookHandler{user: user, password: password}
+}
+
+// Registers a handler for the events of the object and kind.
+//
+// WebhookObjectAll and empty kind match any object and kind. All matching
+// handlers are called in order of registration.
+func (h *WebhookHandler) On(object WebhookObject, kind WebhookEventKind, fn WebhookFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.routes = append(h.routes, webhookRoute{object: object, kind: kind, fn: fn})
+}
+
+// Registers a handler for all the events
+func (h *WebhookHandler) OnAny(fn WebhookFunc) {
+	h.On(WebhookObjectAll, "", fn)
+}
+
+// Adds middleware wrapping the dispatch of every received event.
+//
+// The first added middleware is the outermost one.
+func (h *WebhookHandler) Use(mw ...WebhookMiddleware) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.middleware = append(h.middleware, mw...)
+}
+
+// Dispatches the event through the middleware
+func (h *WebhookHandler) handle(ctx context.Context, e *WebhookEvent) error {
+	h.mu.RLock()
+	middleware := make([]WebhookMiddleware, len(h.middleware))
+	copy(middleware, h.middleware)
+	h.mu.RUnlock()
+
+	fn := WebhookFunc(h.Dispatch)
+	for idx := len(middleware) - 1; idx >= 0; idx-- {
+		fn = middleware[idx](fn)
+	}
+
+	return fn(ctx, e)
+}
+
+// Checks basic auth credentials of the request. Empty user allows any request.
+func checkBasicAuth(r *http.Request, expectedUser string, expectedPassword string) bool {
+	if expectedUser == "" {
+		return true
+	}
+
+	user, password, ok := r.BasicAuth()
+	if !ok {
+		return false
+	}
+
+	user_ok := subtle.ConstantTimeCompare([]byte(user), []byte(expectedUser)) == 1
+	password_ok := subtle.ConstantTimeCompare([]byte(password), []byte(expectedPassword)) == 1
+
+	return user_ok && password_ok
+}
+
+// Calls the handlers matching the event, bypassing the middleware
+func (h *WebhookHandler) Dispatch(ctx context.Context, e *WebhookEvent) error {
+	h.mu.RLock()
+	routes := make([]webhookRoute, len(h.routes))
+	copy(routes, h.routes)
+	h.mu.RUnlock()
+
+	kind := e.Kind()
+
+	for _, route := range routes {
+		if route.object != WebhookObjectAll && route.object != e.Meta.Object {
+			continue
+		}
+
+		if route.kind != "" && route.kind != kind {
+			continue
+		}
+
+		err := route.fn(ctx, e)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !checkBasicAuth(r, h.user, h.password) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="pipedrive"`)
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, webhookMaxBodySize))
+
+	if err != nil {
+		http.Error(w, "Unable to read body", http.StatusBadRequest)
+		return
+	}
+
+	event, err := ParseWebhookEvent(body)
+
+	if err != nil {
+		http.Error(w, "Malformed payload", http.StatusBadRequest)
+		return
+	}
+
+	err = h.handle(r.Context(), event)
+
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Registers a handler for added deals
+func (h *WebhookHandler) OnDealCreated(fn func(ctx context.Context, cur Deal) error) {
+	h.On(WebhookObjectDeal, WebhookEventCreated, func(ctx context.Context, e *WebhookEvent) error {
+		var cur Deal
+		err := e.DecodeCurrent(&cur)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, cur)
+	})
+}
+
+// Registers a handler for updated deals
+func (h *WebhookHandler) OnDealUpdated(fn func(ctx context.Context, prev Deal, cur Deal) error) {
+	h.On(WebhookObjectDeal, WebhookEventUpdated, func(ctx context.Context, e *WebhookEvent) error {
+		var prev, cur Deal
+		err := decodeWebhookPair(e, &prev, &cur)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, prev, cur)
+	})
+}
+
+// Registers a handler for deleted deals
+func (h *WebhookHandler) OnDealDeleted(fn func(ctx context.Context, prev Deal) error) {
+	h.On(WebhookObjectDeal, WebhookEventDeleted, func(ctx context.Context, e *WebhookEvent) error {
+		var prev Deal
+		err := e.DecodePrevious(&prev)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, prev)
+	})
+}
+
+// Registers a handler for added persons
+func (h *WebhookHandler) OnPersonCreated(fn func(ctx context.Context, cur Person) error) {
+	h.On(WebhookObjectPerson, WebhookEventCreated, func(ctx context.Context, e *WebhookEvent) error {
+		var cur Person
+		err := e.DecodeCurrent(&cur)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, cur)
+	})
+}
+
+// Registers a handler for updated persons
+func (h *WebhookHandler) OnPersonUpdated(fn func(ctx context.Context, prev Person, cur Person) error) {
+	h.On(WebhookObjectPerson, WebhookEventUpdated, func(ctx context.Context, e *WebhookEvent) error {
+		var prev, cur Person
+		err := decodeWebhookPair(e, &prev, &cur)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, prev, cur)
+	})
+}
+
+// Registers a handler for deleted persons
+func (h *WebhookHandler) OnPersonDeleted(fn func(ctx context.Context, prev Person) error) {
+	h.On(WebhookObjectPerson, WebhookEventDeleted, func(ctx context.Context, e *WebhookEvent) error {
+		var prev Person
+		err := e.DecodePrevious(&prev)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, prev)
+	})
+}
+
+// Registers a handler for added organizations
+func (h *WebhookHandler) OnOrganizationCreated(fn func(ctx context.Context, cur Organization) error) {
+	h.On(WebhookObjectOrganization, WebhookEventCreated, func(ctx context.Context, e *WebhookEvent) error {
+		var cur Organization
+		err := e.DecodeCurrent(&cur)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, cur)
+	})
+}
+
+// Registers a handler for updated organizations
+func (h *WebhookHandler) OnOrganizationUpdated(fn func(ctx context.Context, prev Organization, cur Organization) error) {
+	h.On(WebhookObjectOrganization, WebhookEventUpdated, func(ctx context.Context, e *WebhookEvent) error {
+		var prev, cur Organization
+		err := decodeWebhookPair(e, &prev, &cur)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, prev, cur)
+	})
+}
+
+// Registers a handler for deleted organizations
+func (h *WebhookHandler) OnOrganizationDeleted(fn func(ctx context.Context, prev Organization) error) {
+	h.On(WebhookObjectOrganization, WebhookEventDeleted, func(ctx context.Context, e *WebhookEvent) error {
+		var prev Organization
+		err := e.DecodePrevious(&prev)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, prev)
+	})
+}
+
+// Registers a handler for added leads
+func (h *WebhookHandler) OnLeadCreated(fn func(ctx context.Context, cur Lead) error) {
+	h.On(WebhookObjectLead, WebhookEventCreated, func(ctx context.Context, e *WebhookEvent) error {
+		var cur Lead
+		err := e.DecodeCurrent(&cur)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, cur)
+	})
+}
+
+// Registers a handler for updated leads
+func (h *WebhookHandler) OnLeadUpdated(fn func(ctx context.Context, prev Lead, cur Lead) error) {
+	h.On(WebhookObjectLead, WebhookEventUpdated, func(ctx context.Context, e *WebhookEvent) error {
+		var prev, cur Lead
+		err := decodeWebhookPair(e, &prev, &cur)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, prev, cur)
+	})
+}
+
+// Registers a handler for deleted leads
+func (h *WebhookHandler) OnLeadDeleted(fn func(ctx context.Context, prev Lead) error) {
+	h.On(WebhookObjectLead, WebhookEventDeleted, func(ctx context.Context, e *WebhookEvent) error {
+		var prev Lead
+		err := e.DecodePrevious(&prev)
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, prev)
+	})
+}
+
+func decodeWebhookPair(e *WebhookEvent, prev interface{}, cur interface{}) error {
+	err := e.DecodePrevious(prev)
+	if err != nil {
+		return err
+	}
+
+	return e.DecodeCurrent(cur)
+}
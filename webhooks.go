@@ -1,6 +1,86 @@
 package pipedrive
 
-import "net/http"
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Webhook event actions.
+//
+// Version 1.0 webhooks use added, updated, deleted and merged,
+// version 2.0 webhooks use create, change and delete.
+type WebhookAction string
+
+const (
+	WebhookActionAdded   WebhookAction = "added"
+	WebhookActionUpdated WebhookAction = "updated"
+	WebhookActionDeleted WebhookAction = "deleted"
+	WebhookActionMerged  WebhookAction = "merged"
+	WebhookActionCreate  WebhookAction = "create"
+	WebhookActionChange  WebhookAction = "change"
+	WebhookActionDelete  WebhookAction = "delete"
+	WebhookActionAll     WebhookAction = "*"
+)
+
+// Webhook event objects
+type WebhookObject string
+
+const (
+	WebhookObjectActivity     WebhookObject = "activity"
+	WebhookObjectActivityType WebhookObject = "activityType"
+	WebhookObjectDeal         WebhookObject = "deal"
+	WebhookObjectLead         WebhookObject = "lead"
+	WebhookObjectNote         WebhookObject = "note"
+	WebhookObjectOrganization WebhookObject = "organization"
+	WebhookObjectPerson       WebhookObject = "person"
+	WebhookObjectPipeline     WebhookObject = "pipeline"
+	WebhookObjectProduct      WebhookObject = "product"
+	WebhookObjectStage        WebhookObject = "stage"
+	WebhookObjectUser         WebhookObject = "user"
+	WebhookObjectAll          WebhookObject = "*"
+)
+
+type WebhookVersion string
+
+const (
+	WebhookVersion1 WebhookVersion = "1.0"
+	WebhookVersion2 WebhookVersion = "2.0"
+)
+
+// Webhook subscription.
+//
+// The same model is used to create webhooks, read-only fields are
+// omitted when empty.
+type Webhook struct {
+	Id              int            `json:"id,omitempty"`
+	SubscriptionUrl string         `json:"subscription_url"`
+	EventAction     WebhookAction  `json:"event_action"`
+	EventObject     WebhookObject  `json:"event_object"`
+	Version         WebhookVersion `json:"version,omitempty"`
+
+	// The ID of the user that this webhook will be authorized with. If omitted,
+	// the authorized user is used.
+	UserId int `json:"user_id,omitempty"`
+
+	// HTTP basic auth credentials sent along with every delivery
+	HttpAuthUser     string `json:"http_auth_user,omitempty"`
+	HttpAuthPassword string `json:"http_auth_password,omitempty"`
+
+	// Read only fields
+	CompanyId        int    `json:"company_id,omitempty"`
+	OwnerId          int    `json:"owner_id,omitempty"`
+	IsActive         int    `json:"is_active,omitempty"`
+	Type             string `json:"type,omitempty"`
+	AddTime          string `json:"add_time,omitempty"`
+	RemoveTime       string `json:"remove_time,omitempty"`
+	RemoveReason     string `json:"remove_reason,omitempty"`
+	LastDeliveryTime string `json:"last_delivery_time,omitempty"`
+	LastHttpStatus   int    `json:"last_http_status,omitempty"`
+}
 
 func (p *Pipedrive) ListWebhooks() (*PipedriveResponse, error) {
 	url := p.makeApiEndpoint("webhooks")
@@ -13,3 +93,203 @@ func (p *Pipedrive) ListWebhooks() (*PipedriveResponse, error) {
 	pd_resp := p.readResponse(resp)
 	return pd_resp, nil
 }
+
+// Create a new webhook
+//
+// Creates a new webhook and returns its details. Note that specifying an
+// event which triggers the webhook combines 2 parameters - EventAction and
+// EventObject. E.g., use "*.*" for getting notifications about all events,
+// "added.deal" for any newly added deals, "deleted.persons" for any deleted
+// persons, etc.
+//
+// https://developers.pipedrive.com/docs/api/v1/Webhooks#addWebhook
+func (p *Pipedrive) CreateWebhook(hook Webhook) (*PipedriveResponse, error) {
+	url := p.makeApiEndpoint("webhooks")
+
+	if hook.SubscriptionUrl == "" {
+		return nil, errors.New("Subscription url is required")
+	}
+
+	if hook.EventAction == "" || hook.EventObject == "" {
+		return nil, errors.New("Event action and event object are required")
+	}
+
+	body := Webhook{
+		SubscriptionUrl:  hook.SubscriptionUrl,
+		EventAction:      hook.EventAction,
+		EventObject:      hook.EventObject,
+		Version:          hook.Version,
+		UserId:           hook.UserId,
+		HttpAuthUser:     hook.HttpAuthUser,
+		HttpAuthPassword: hook.HttpAuthPassword,
+	}
+
+	json_data, err := json.Marshal(body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(json_data)
+	resp, err := http.Post(url.String(), "application/json", buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Delete existing webhook
+//
+// Deletes the specified webhook.
+//
+// https://developers.pipedrive.com/docs/api/v1/Webhooks#deleteWebhook
+func (p *Pipedrive) DeleteWebhook(id int) (*PipedriveResponse, error) {
+	ep := fmt.Sprintf("webhooks/%d", id)
+	url := p.makeApiEndpoint(ep)
+
+	req, err := http.NewRequest("DELETE", url.String(), strings.NewReader(""))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var client http.Client
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pd_resp := p.readResponse(resp)
+	return pd_resp, nil
+}
+
+// Returns true if existing webhook satisfies the desired one.
+// Empty version, zero user id and empty auth user of the desired webhook match any value.
+// Webhooks deactivated by Pipedrive never match, they receive no deliveries.
+func (hook Webhook) matches(existing Webhook) bool {
+	if existing.IsActive == 0 {
+		return false
+	}
+
+	if hook.SubscriptionUrl != existing.SubscriptionUrl {
+		return false
+	}
+
+	if hook.EventAction != existing.EventAction || hook.EventObject != existing.EventObject {
+		return false
+	}
+
+	if hook.Version != "" && hook.Version != existing.Version {
+		return false
+	}
+
+	if hook.UserId > 0 && hook.UserId != existing.UserId {
+		return false
+	}
+
+	if hook.HttpAuthUser != "" && hook.HttpAuthUser != existing.HttpAuthUser {
+		return false
+	}
+
+	return true
+}
+
+// Result of webhooks reconciliation
+type WebhooksReconciliation struct {
+	// Existing webhooks matching the desired ones
+	Kept []Webhook
+
+	// Webhooks created for the desired ones which did not exist
+	Created []Webhook
+
+	// Stale webhooks which were removed
+	Deleted []Webhook
+}
+
+// Ensure webhooks
+//
+// Reconciles the desired set of subscriptions against the existing webhooks:
+// missing ones are created and stale ones are removed. Only the existing
+// webhooks for which owns returns true are considered stale candidates, so
+// webhooks created by other integrations are not touched. If owns is nil
+// nothing is removed. Deactivated webhooks are never kept, the desired ones
+// are created again and the deactivated ones are removed if owned.
+//
+// On error the result contains the changes made so far.
+func (p *Pipedrive) EnsureWebhooks(desired []Webhook, owns func(Webhook) bool) (*WebhooksReconciliation, error) {
+	result := &WebhooksReconciliation{}
+
+	pd_resp, err := p.ListWebhooks()
+
+	if err != nil {
+		return result, err
+	}
+
+	var existing []Webhook
+
+	// Company without webhooks returns no data
+	if pd_resp.Status >= 400 || pd_resp.Data != nil {
+		err = pd_resp.DecodeData(&existing)
+
+		if err != nil {
+			return result, err
+		}
+	}
+
+	used := make([]bool, len(existing))
+
+	for _, hook := range desired {
+		found := false
+		for idx, ex := range existing {
+			if !used[idx] && hook.matches(ex) {
+				used[idx] = true
+				found = true
+				result.Kept = append(result.Kept, ex)
+				break
+			}
+		}
+
+		if found {
+			continue
+		}
+
+		pd_resp, err = p.CreateWebhook(hook)
+
+		if err != nil {
+			return result, err
+		}
+
+		var created Webhook
+		err = pd_resp.DecodeData(&created)
+
+		if err != nil {
+			return result, err
+		}
+
+		result.Created = append(result.Created, created)
+	}
+
+	for idx, ex := range existing {
+		if used[idx] || owns == nil || !owns(ex) {
+			continue
+		}
+
+		pd_resp, err = p.DeleteWebhook(ex.Id)
+
+		if err != nil {
+			return result, err
+		}
+
+		if pd_resp.Status >= 400 {
+			return result, errors.New(pd_resp.ErrorMsg)
+		}
+
+		result.Deleted = append(result.Deleted, ex)
+	}
+
+	return result, nil
+}